@@ -0,0 +1,131 @@
+// Package acctest provides shared helpers for the provider's acceptance
+// tests, which run against the in-memory fake API in hightouchtest rather than
+// a real Hightouch workspace.
+package acctest
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+	"terraform-provider-hightouch/pkg/provider"
+)
+
+// ProtoV6ProviderFactories instantiates the provider for acceptance tests.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"hightouch": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// WriteOnlyVersionChecks skips tests whose configuration sets write-only
+// attributes, such as the password of a Snowflake source or the API key of an
+// Iterable destination, on Terraform versions that do not support them.
+var WriteOnlyVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(tfversion.Version1_11_0),
+}
+
+// ProviderConfig returns a provider block that points at the fake server.
+func ProviderConfig(server *hightouchtest.Server) string {
+	return fmt.Sprintf(`
+provider "hightouch" {
+  api_key      = %q
+  api_base_url = %q
+}
+`, hightouchtest.APIKey, server.URL)
+}
+
+// CheckDestroyed returns a CheckDestroy function that fails if any resource of
+// resourceType in state still exists according to exists.
+func CheckDestroyed(resourceType string, exists func(id int) bool) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			id, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("%s has a non-numeric ID %q", resourceType, rs.Primary.ID)
+			}
+			if exists(id) {
+				return fmt.Errorf("%s %d still exists", resourceType, id)
+			}
+		}
+		return nil
+	}
+}
+
+// StoreID returns a check that saves the ID of resourceName, for steps that
+// change the object on the fake server directly.
+func StoreID(resourceName string, id *int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var err error
+		*id, err = resourceID(s, resourceName)
+		return err
+	}
+}
+
+// CheckSourceConfiguration checks a configuration key of the source
+// resourceName as stored by the fake server. Write-only secrets never appear
+// in state, so this is how tests check what was sent.
+func CheckSourceConfiguration(server *hightouchtest.Server, resourceName, key string, want interface{}) resource.TestCheckFunc {
+	return checkConfiguration("source", server.SourceConfiguration, resourceName, key, want)
+}
+
+// CheckDestinationConfiguration checks a configuration key of the destination
+// resourceName as stored by the fake server.
+func CheckDestinationConfiguration(server *hightouchtest.Server, resourceName, key string, want interface{}) resource.TestCheckFunc {
+	return checkConfiguration("destination", server.DestinationConfiguration, resourceName, key, want)
+}
+
+// CheckSourceTunnelKey checks the SSH tunnel private key of the source
+// resourceName as stored by the fake server.
+func CheckSourceTunnelKey(server *hightouchtest.Server, resourceName, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := resourceID(s, resourceName)
+		if err != nil {
+			return err
+		}
+		tunnel, _ := server.SourceConfiguration(id)["ssh_tunnel"].(map[string]interface{})
+		if got := tunnel["private_key"]; got != want {
+			return fmt.Errorf("source %d has tunnel private key %v, want %q", id, got, want)
+		}
+		return nil
+	}
+}
+
+func checkConfiguration(
+	object string,
+	configuration func(id int) map[string]interface{},
+	resourceName string,
+	key string,
+	want interface{},
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := resourceID(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if got := configuration(id)[key]; got != want {
+			return fmt.Errorf("%s %d has %s %v, want %v", object, id, key, got, want)
+		}
+		return nil
+	}
+}
+
+// resourceID returns the numeric ID of resourceName in state.
+func resourceID(s *terraform.State, resourceName string) (int, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return 0, fmt.Errorf("%s is not in state", resourceName)
+	}
+	id, err := strconv.Atoi(rs.Primary.ID)
+	if err != nil {
+		return 0, fmt.Errorf("%s has a non-numeric ID %q", resourceName, rs.Primary.ID)
+	}
+	return id, nil
+}
@@ -0,0 +1,150 @@
+// Package authmethod checks and sends the attributes of sources that support
+// several auth methods, each with its own attributes and write-only secrets.
+package authmethod
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Method lists the attributes of an auth method.
+type Method struct {
+	// Required are the attributes the method requires.
+	Required []string
+	// Secrets are the write-only attributes the method sends to Hightouch.
+	// They may be optional.
+	Secrets []string
+	// Version is the attribute to change to send the secrets again, if the
+	// method has secrets.
+	Version string
+}
+
+// Methods are the auth methods of a source, keyed by the values of its
+// auth_method attribute, which are also the values of the auth_method
+// configuration key in the Hightouch API.
+type Methods struct {
+	// Default is the method of sources that do not set auth_method.
+	Default string
+	// ByName holds the attributes of each method.
+	ByName map[string]Method
+}
+
+// Name returns the auth method of a source, which is the default when the
+// API does not say otherwise.
+func (m Methods) Name(method string) string {
+	if method == "" {
+		return m.Default
+	}
+	return method
+}
+
+// Validate checks that exactly the attributes of the configured auth method
+// are set. values holds the configured value of every attribute of every
+// method. Unknown and invalid methods are not reported, the latter being left
+// to the validator of auth_method.
+func (m Methods) Validate(method types.String, values map[string]types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if method.IsUnknown() {
+		return diags
+	}
+	name := m.Name(method.ValueString())
+	attributes, ok := m.ByName[name]
+	if !ok {
+		return diags
+	}
+
+	allowed := make(map[string]bool)
+	for _, attribute := range attributes.Required {
+		allowed[attribute] = true
+		if values[attribute].IsNull() {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Missing Authentication Attribute",
+				fmt.Sprintf("%s is required when auth_method is %q.", attribute, name),
+			)
+		}
+	}
+	for _, attribute := range attributes.Secrets {
+		allowed[attribute] = true
+	}
+	for attribute, value := range values {
+		if !allowed[attribute] && !value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Unexpected Authentication Attribute",
+				fmt.Sprintf("%s cannot be set when auth_method is %q.", attribute, name),
+			)
+		}
+	}
+	return diags
+}
+
+// SecretsChanged reports whether an update must send the write-only secrets
+// of the planned auth method, because the method or its version attribute
+// changed. The API keeps stored secrets when they are omitted.
+func (m Methods) SecretsChanged(
+	ctx context.Context,
+	plan tfsdk.Plan,
+	state tfsdk.State,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var planMethod, stateMethod types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("auth_method"), &planMethod)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("auth_method"), &stateMethod)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	if !planMethod.Equal(stateMethod) {
+		return true, diags
+	}
+
+	version := m.ByName[m.Name(planMethod.ValueString())].Version
+	if version == "" {
+		return false, diags
+	}
+	var planVersion, stateVersion types.Int64
+	diags.Append(plan.GetAttribute(ctx, path.Root(version), &planVersion)...)
+	diags.Append(state.GetAttribute(ctx, path.Root(version), &stateVersion)...)
+	return !planVersion.Equal(stateVersion), diags
+}
+
+// Secrets returns the configuration keys of the write-only secrets of an auth
+// method. Write-only values are never part of the plan, so they are read from
+// config. The secrets of the other methods are set to nil, so that the API
+// clears them when a source switches methods.
+func (m Methods) Secrets(
+	ctx context.Context,
+	config tfsdk.Config,
+	method string,
+) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	method = m.Name(method)
+
+	secrets := make(map[string]interface{})
+	for other, attributes := range m.ByName {
+		if other == method {
+			continue
+		}
+		for _, attribute := range attributes.Secrets {
+			secrets[attribute] = nil
+		}
+	}
+	for _, attribute := range m.ByName[method].Secrets {
+		var secret types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &secret)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if secret.IsNull() {
+			secrets[attribute] = nil
+		} else {
+			secrets[attribute] = secret.ValueString()
+		}
+	}
+	return secrets, diags
+}
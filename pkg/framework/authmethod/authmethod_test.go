@@ -0,0 +1,72 @@
+package authmethod
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testMethods = Methods{
+	Default: "password",
+	ByName: map[string]Method{
+		"password": {
+			Required: []string{"password"},
+			Secrets:  []string{"password"},
+		},
+		"key_pair": {
+			Required: []string{"private_key"},
+			Secrets:  []string{"private_key", "private_key_passphrase"},
+		},
+	},
+}
+
+func TestMethodsName(t *testing.T) {
+	if got := testMethods.Name(""); got != "password" {
+		t.Errorf("Name(\"\") = %q, want the default", got)
+	}
+	if got := testMethods.Name("key_pair"); got != "key_pair" {
+		t.Errorf("Name(\"key_pair\") = %q, want key_pair", got)
+	}
+}
+
+func TestMethodsValidate(t *testing.T) {
+	set := types.StringValue("value")
+	unset := types.StringNull()
+
+	tests := []struct {
+		name     string
+		method   types.String
+		password types.String
+		key      types.String
+		phrase   types.String
+		want     []string
+	}{
+		{name: "default method", method: unset, password: set, key: unset, phrase: unset},
+		{name: "optional secret", method: types.StringValue("key_pair"), password: unset, key: set, phrase: set},
+		{name: "missing attribute", method: types.StringValue("key_pair"), password: unset, key: unset, phrase: unset, want: []string{"Missing Authentication Attribute: private_key"}},
+		{name: "unexpected attribute", method: unset, password: set, key: set, phrase: unset, want: []string{"Unexpected Authentication Attribute: private_key"}},
+		{name: "unknown method", method: types.StringUnknown(), password: set, key: set, phrase: set},
+		{name: "invalid method", method: types.StringValue("oauth"), password: set, key: set, phrase: set},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := testMethods.Validate(tt.method, map[string]types.String{
+				"password":               tt.password,
+				"private_key":            tt.key,
+				"private_key_passphrase": tt.phrase,
+			})
+
+			var got []string
+			for _, d := range diags.Errors() {
+				got = append(got, d.Summary()+": "+d.(diag.DiagnosticWithPath).Path().String())
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() errors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
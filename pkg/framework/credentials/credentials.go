@@ -0,0 +1,84 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"terraform-provider-hightouch/pkg/helper"
+)
+
+// Merge builds the configuration sent to the API from the JSON configuration
+// object and the write-only credentials attribute. Write-only values are never
+// part of the plan, so credentials are read from config. A key may not be set
+// in both.
+func Merge(
+	ctx context.Context,
+	configurationJSON string,
+	config tfsdk.Config,
+) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := helper.DecodeJSONObject(configurationJSON)
+	if err != nil {
+		diags.AddAttributeError(path.Root("configuration"), "Invalid Configuration JSON", "Could not parse configuration JSON: "+err.Error())
+		return nil, diags
+	}
+
+	var credentials types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("credentials"), &credentials)...)
+	if diags.HasError() || credentials.IsNull() {
+		return configuration, diags
+	}
+
+	values := map[string]string{}
+	diags.Append(credentials.ElementsAs(ctx, &values, false)...)
+	for key, value := range values {
+		if _, ok := configuration[key]; ok {
+			diags.AddAttributeError(
+				path.Root("credentials").AtMapKey(key),
+				"Duplicate Configuration Key",
+				fmt.Sprintf("%q is set in both configuration and credentials. Set it in only one of them.", key),
+			)
+			continue
+		}
+		configuration[key] = value
+	}
+	return configuration, diags
+}
+
+// ClearFromState returns a state upgrader that sets attributes to null in the
+// prior state, for secrets that became write-only and must no longer be kept
+// in state. Attributes missing from the prior state, such as a new version
+// attribute, are added as null.
+func ClearFromState(attributes ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The prior state is missing.")
+				return
+			}
+			var state map[string]interface{}
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "Could not parse the prior state: "+err.Error())
+				return
+			}
+
+			for _, attribute := range attributes {
+				state[attribute] = nil
+			}
+
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "Could not marshal the upgraded state: "+err.Error())
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
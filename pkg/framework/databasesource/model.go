@@ -0,0 +1,43 @@
+package databasesource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/sshtunnel"
+)
+
+// Model maps the resource schema data for a database source in Hightouch.
+type Model struct {
+	ID                types.Int64      `tfsdk:"id"`
+	Name              types.String     `tfsdk:"name"`
+	Slug              types.String     `tfsdk:"slug"`
+	Type              types.String     `tfsdk:"type"`
+	Host              types.String     `tfsdk:"host"`
+	Port              types.Int64      `tfsdk:"port"`
+	Database          types.String     `tfsdk:"database"`
+	User              types.String     `tfsdk:"user"`
+	Password          types.String     `tfsdk:"password"`
+	PasswordWOVersion types.Int64      `tfsdk:"password_wo_version"`
+	SSLMode           types.String     `tfsdk:"ssl_mode"`
+	SSHTunnel         *sshtunnel.Model `tfsdk:"ssh_tunnel"`
+	WorkspaceID       types.Int64      `tfsdk:"workspace_id"`
+	CreatedAt         types.String     `tfsdk:"created_at"`
+	UpdatedAt         types.String     `tfsdk:"updated_at"`
+}
+
+// DataSourceModel maps the data source schema data for a database source. It
+// has no password, which is never read back.
+type DataSourceModel struct {
+	ID          types.Int64                `tfsdk:"id"`
+	Name        types.String               `tfsdk:"name"`
+	Slug        types.String               `tfsdk:"slug"`
+	Type        types.String               `tfsdk:"type"`
+	Host        types.String               `tfsdk:"host"`
+	Port        types.Int64                `tfsdk:"port"`
+	Database    types.String               `tfsdk:"database"`
+	User        types.String               `tfsdk:"user"`
+	SSLMode     types.String               `tfsdk:"ssl_mode"`
+	SSHTunnel   *sshtunnel.DataSourceModel `tfsdk:"ssh_tunnel"`
+	WorkspaceID types.Int64                `tfsdk:"workspace_id"`
+	CreatedAt   types.String               `tfsdk:"created_at"`
+	UpdatedAt   types.String               `tfsdk:"updated_at"`
+}
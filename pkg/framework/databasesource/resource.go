@@ -0,0 +1,325 @@
+// Package databasesource implements the resources and data sources for the
+// Hightouch sources that connect to a Postgres-compatible database,
+// hightouch_postgres_source and hightouch_redshift_source. They only differ in
+// their source type, default port and descriptions.
+package databasesource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/identity"
+	"terraform-provider-hightouch/pkg/framework/sshtunnel"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// Kind describes a type of database source.
+type Kind struct {
+	// Type is the type of the sources in Hightouch, e.g. "postgres".
+	Type string
+	// Title names the type in descriptions and logs, e.g. "Postgres".
+	Title string
+	// DefaultPort is the port of the database when none is configured.
+	DefaultPort int64
+	// HostDescription describes the host attribute of the resource.
+	HostDescription string
+	// PortDescription describes the port attribute of the resource.
+	PortDescription string
+}
+
+// Resource is the resource implementation, shared by every kind of database
+// source. Types embedding it add Metadata and Schema.
+type Resource struct {
+	Kind   Kind
+	client *hightouch.Client
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
+// Configure adds the hightouch_resources configured client to the resource.
+func (r *Resource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial state.
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Retrieve values from plan
+	var plan Model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert configuration from Terraform types to Go types
+	config := make(map[string]interface{})
+	config["host"] = plan.Host.ValueString()
+	config["port"] = plan.Port.ValueInt64()
+	config["database"] = plan.Database.ValueString()
+	config["user"] = plan.User.ValueString()
+	config["ssl_mode"] = plan.SSLMode.ValueStringPointer()
+
+	// The password and tunnel private key are write-only, so they are only in
+	// the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	tunnel, diags := sshtunnel.Configuration(ctx, req.Config, plan.SSHTunnel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config["password"] = password.ValueString()
+	config["ssh_tunnel"] = tunnel
+
+	// Call the API to create the source
+	source, err := r.client.CreateHightouchSource(
+		ctx,
+		plan.Name.ValueString(),
+		plan.Slug.ValueString(),
+		plan.Type.ValueString(),
+		config,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating source", "Could not create source, unexpected error: "+err.Error())
+		return
+	}
+
+	// Map response body to the plan
+	sourceID := *source.ID
+	plan.ID = types.Int64Value(int64(sourceID))
+	plan.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	plan.CreatedAt = types.StringValue(source.CreatedAt.String())
+	plan.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the resource state with the latest data.
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state Model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed source from Hightouch API
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
+		return
+	}
+	source, err := r.client.GetHightouchSource(ctx, sourceID)
+	if hightouch.IsNotFound(err) {
+		// The source was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, r.Kind.Title+" source no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"source_id": sourceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", "Could not read source, unexpected error: "+err.Error())
+		return
+	}
+
+	workspaceID := int64(source.WorkspaceID)
+	if workspaceID == 0 {
+		resp.Diagnostics.AddError("Invalid Workspace ID", "The workspace ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
+		return
+	}
+
+	// Overwrite state with refreshed values
+	state.ID = types.Int64Value(int64(sourceID))
+	state.Name = types.StringValue(source.Name)
+	state.Slug = types.StringValue(source.Slug)
+	state.Type = types.StringValue(source.Type)
+	state.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+	state.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	state.CreatedAt = types.StringValue(source.CreatedAt.String())
+
+	// Convert configuration from Go types to Terraform types. The password and
+	// tunnel private key are write-only and never read back.
+	configuration, err := hightouch.ParseDatabaseConfiguration(source.Type, source.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", fmt.Sprintf("Could not decode the configuration of source %d: %s", sourceID, err.Error()))
+		return
+	}
+	state.Host = types.StringValue(configuration.Host)
+	state.Port = types.Int64Value(r.Kind.DefaultPort)
+	if configuration.Port != nil {
+		state.Port = types.Int64Value(*configuration.Port)
+	}
+	state.Database = types.StringValue(configuration.Database)
+	state.User = types.StringValue(configuration.User)
+	state.SSLMode = types.StringPointerValue(configuration.SSLMode)
+	state.SSHTunnel = sshtunnel.FromConfiguration(configuration.SSHTunnel, state.SSHTunnel)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated state.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before updating. Please ensure the resource has been created successfully before attempting to update it.")
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating "+r.Kind.Title+" source", map[string]interface{}{
+		"source_id": sourceID,
+	})
+
+	// Convert configuration from Terraform types to Go types
+	config := make(map[string]interface{})
+	config["host"] = plan.Host.ValueString()
+	config["port"] = plan.Port.ValueInt64()
+	config["database"] = plan.Database.ValueString()
+	config["user"] = plan.User.ValueString()
+	config["ssl_mode"] = plan.SSLMode.ValueStringPointer()
+
+	// The API keeps stored secrets when they are omitted, so the password is
+	// only sent when password_wo_version changes, and the tunnel only when it
+	// changes
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config["password"] = password.ValueString()
+	}
+	if sshtunnel.Changed(plan.SSHTunnel, state.SSHTunnel) {
+		tunnel, diags := sshtunnel.Configuration(ctx, req.Config, plan.SSHTunnel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config["ssh_tunnel"] = tunnel
+	}
+
+	// Call the API to update the source
+	source, err := r.client.UpdateHightouchSource(
+		ctx,
+		sourceID,
+		plan.Name.ValueString(),
+		config,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating source", "Could not update source, unexpected error: "+err.Error())
+		return
+	}
+
+	// Update the plan with the response from the API
+	plan.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+	plan.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	plan.ID = types.Int64Value(int64(sourceID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+}
+
+// Delete deletes the resource from the remote API.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state Model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before deleting.")
+		return
+	}
+
+	// A source that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchSource(ctx, sourceID)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			"Source Has Dependent Models",
+			fmt.Sprintf("Source %d cannot be deleted while models still reference it. Delete those models first. If they are managed by Terraform, make sure they reference this source through its id attribute (e.g. source_id = <this resource>.id) so Terraform destroys them before it.\n\n%s", sourceID, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting source", "Could not delete source, unexpected error: "+err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state. The source can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	identity.ImportSource(ctx, r.client, r.Kind.Type, req, resp)
+}
@@ -0,0 +1,164 @@
+package databasesource
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-hightouch/pkg/framework/sshtunnel"
+)
+
+// sslModes are the libpq SSL modes Hightouch supports.
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// ResourceSchema returns the schema of the resource for a kind of database
+// source.
+func ResourceSchema(kind Kind) schema.Schema {
+	return schema.Schema{
+		Description: fmt.Sprintf("Represents a Hightouch %s Source.", kind.Title),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the source.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the source.",
+				Required:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the source. Slugs cannot be changed in place, so changing it replaces the source.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of the source, '%s'.", kind.Type),
+				Computed:    true,
+				Default:     stringdefault.StaticString(kind.Type),
+			},
+			"host": schema.StringAttribute{
+				Description: kind.HostDescription,
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: kind.PortDescription,
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(kind.DefaultPort),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database name.",
+				Required:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "User to connect as.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of user. Write-only: it is never stored in state, so change password_wo_version to send a new password. Requires Terraform 1.11 or later.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send the current password to Hightouch, for example after rotating it.",
+				Optional:    true,
+			},
+			"ssl_mode": schema.StringAttribute{
+				Description: "SSL mode of the connection: `disable`, `allow`, `prefer`, `require`, `verify-ca` or `verify-full`. Defaults to the Hightouch default.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sslModes...),
+				},
+			},
+			"ssh_tunnel": sshtunnel.ResourceAttribute,
+			"workspace_id": schema.Int64Attribute{
+				Description: "The ID of the workspace that the source belongs to.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the source was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the source was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// DataSourceSchema returns the schema of the data source for a kind of
+// database source.
+func DataSourceSchema(kind Kind) datasourceschema.Schema {
+	return datasourceschema.Schema{
+		Description: fmt.Sprintf("Fetches information about a Hightouch %s Source.", kind.Title),
+		Attributes: map[string]datasourceschema.Attribute{
+			"id": datasourceschema.Int64Attribute{
+				Description: "The ID of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": datasourceschema.StringAttribute{
+				Description: "The name of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up; the lookup fails if several sources share the name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"slug": datasourceschema.StringAttribute{
+				Description: "The slug of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": datasourceschema.StringAttribute{
+				Description: "The type of the source.",
+				Computed:    true,
+			},
+			"host": datasourceschema.StringAttribute{
+				Description: "Hostname or IP address of the database server.",
+				Computed:    true,
+			},
+			"port": datasourceschema.Int64Attribute{
+				Description: "Port of the database server.",
+				Computed:    true,
+			},
+			"database": datasourceschema.StringAttribute{
+				Description: "Database name.",
+				Computed:    true,
+			},
+			"user": datasourceschema.StringAttribute{
+				Description: "User to connect as.",
+				Computed:    true,
+			},
+			"ssl_mode": datasourceschema.StringAttribute{
+				Description: "SSL mode of the connection.",
+				Computed:    true,
+			},
+			"ssh_tunnel": sshtunnel.DataSourceAttribute,
+			"workspace_id": datasourceschema.Int64Attribute{
+				Description: "The ID of the workspace that the source belongs to.",
+				Computed:    true,
+			},
+			"created_at": datasourceschema.StringAttribute{
+				Description: "The timestamp when the source was created.",
+				Computed:    true,
+			},
+			"updated_at": datasourceschema.StringAttribute{
+				Description: "The timestamp when the source was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,357 @@
+// Package generic implements the resources for Hightouch objects of any type,
+// hightouch_source and hightouch_destination. Both take the non-secret
+// settings of the object as a JSON configuration and its secrets as a
+// write-only credentials map merged into it.
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"terraform-provider-hightouch/pkg/framework/credentials"
+	"terraform-provider-hightouch/pkg/framework/identity"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+	"time"
+)
+
+// Model maps the resource schema data for an object of any type.
+type Model struct {
+	ID                 types.Int64         `tfsdk:"id"`
+	Name               types.String        `tfsdk:"name"`
+	Slug               types.String        `tfsdk:"slug"`
+	Type               types.String        `tfsdk:"type"`
+	Configuration      jsontype.Normalized `tfsdk:"configuration"`
+	Credentials        types.Map           `tfsdk:"credentials"`
+	CredentialsVersion types.Int64         `tfsdk:"credentials_version"`
+	WorkspaceID        types.Int64         `tfsdk:"workspace_id"`
+	CreatedAt          types.String        `tfsdk:"created_at"`
+	UpdatedAt          types.String        `tfsdk:"updated_at"`
+}
+
+// Object is a source or destination as returned by the API.
+type Object struct {
+	ID            int
+	Name          string
+	Slug          string
+	Type          string
+	WorkspaceID   int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Configuration map[string]interface{}
+}
+
+// Kind describes a kind of object and the client methods that manage it.
+type Kind struct {
+	// Name is the name of the kind in messages, such as "source".
+	Name string
+	// Title is Name capitalized, such as "Source".
+	Title string
+	// Dependents names the objects that reference an object of this kind and
+	// block its deletion, such as "models".
+	Dependents string
+	// ReferenceAttribute is the attribute through which dependents reference
+	// an object of this kind, such as "source_id".
+	ReferenceAttribute string
+
+	Get        func(ctx context.Context, client *hightouch.Client, id int) (*Object, error)
+	FindBySlug func(ctx context.Context, client *hightouch.Client, slug string) (*Object, error)
+	Create     func(ctx context.Context, client *hightouch.Client, name, slug, objectType string, configuration map[string]interface{}) (*Object, error)
+	Update     func(ctx context.Context, client *hightouch.Client, id int, name string, configuration map[string]interface{}) (*Object, error)
+	Delete     func(ctx context.Context, client *hightouch.Client, id int) error
+}
+
+// Resource implements every operation of the resource for objects of Kind
+// except Metadata and Schema, which the embedding resource provides.
+type Resource struct {
+	Kind   Kind
+	client *hightouch.Client
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
+// Configure adds the hightouch configured client to the resource.
+func (r *Resource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial state.
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Retrieve values from plan
+	var plan Model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Credentials are write-only, so they are only available in the configuration
+	config, diags := credentials.Merge(ctx, plan.Configuration.ValueString(), req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := r.Kind.Create(
+		ctx,
+		r.client,
+		plan.Name.ValueString(),
+		plan.Slug.ValueString(),
+		plan.Type.ValueString(),
+		config,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating "+r.Kind.Name,
+			fmt.Sprintf("Could not create %s, unexpected error: %s", r.Kind.Name, err.Error()),
+		)
+		return
+	}
+
+	// Map response body to the plan
+	plan.ID = types.Int64Value(int64(object.ID))
+	plan.WorkspaceID = types.Int64Value(int64(object.WorkspaceID))
+	plan.CreatedAt = types.StringValue(object.CreatedAt.String())
+	plan.UpdatedAt = types.StringValue(object.UpdatedAt.String())
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, object.WorkspaceID, object.Slug)...)
+}
+
+// Read refreshes the resource state with the latest data.
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state Model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if id == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s ID", r.Kind.Title),
+			fmt.Sprintf("The %s ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.", r.Kind.Name),
+		)
+		return
+	}
+	object, err := r.Kind.Get(ctx, r.client, id)
+	if hightouch.IsNotFound(err) {
+		// The object was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, r.Kind.Title+" no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			r.Kind.Name + "_id": id,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.Kind.Name,
+			fmt.Sprintf("Could not read %s, unexpected error: %s", r.Kind.Name, err.Error()),
+		)
+		return
+	}
+
+	// Only keep the configuration keys Terraform manages. This drops
+	// credentials and defaults added by the API, which would otherwise show up
+	// as drift. After an import there is no prior configuration, so every key
+	// is kept except credentials, which must stay out of state.
+	configuration := r.client.WithoutSensitiveKeys(object.Configuration)
+	if !state.Configuration.IsNull() {
+		prior, err := helper.DecodeJSONObject(state.Configuration.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration JSON", "Could not parse configuration JSON in state: "+err.Error())
+			return
+		}
+		configuration = helper.ProjectKeys(object.Configuration, prior)
+	}
+	configJSON, err := json.Marshal(configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshaling configuration", "Could not marshal configuration to JSON: "+err.Error())
+		return
+	}
+
+	// Overwrite state with refreshed values
+	state.ID = types.Int64Value(int64(id))
+	state.Name = types.StringValue(object.Name)
+	state.Slug = types.StringValue(object.Slug)
+	state.Type = types.StringValue(object.Type)
+	state.Configuration = jsontype.NewNormalizedValue(string(configJSON))
+	state.WorkspaceID = types.Int64Value(int64(object.WorkspaceID))
+	state.CreatedAt = types.StringValue(object.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(object.UpdatedAt.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, object.WorkspaceID, object.Slug)...)
+}
+
+// Update updates the resource and sets the updated state.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if id == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s ID", r.Kind.Title),
+			fmt.Sprintf("The %s ID must be set before updating. Please ensure the resource has been created successfully before attempting to update it.", r.Kind.Name),
+		)
+		return
+	}
+
+	config, diags := credentials.Merge(ctx, plan.Configuration.ValueString(), req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := r.Kind.Update(ctx, r.client, id, plan.Name.ValueString(), config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating "+r.Kind.Name,
+			fmt.Sprintf("Could not update %s, unexpected error: %s", r.Kind.Name, err.Error()),
+		)
+		return
+	}
+
+	// Update the plan with the response from the API
+	plan.UpdatedAt = types.StringValue(object.UpdatedAt.String())
+	plan.WorkspaceID = types.Int64Value(int64(object.WorkspaceID))
+	plan.ID = types.Int64Value(int64(id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, object.WorkspaceID, object.Slug)...)
+}
+
+// Delete deletes the resource from the remote API.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state Model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if id == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s ID", r.Kind.Title),
+			fmt.Sprintf("The %s ID must be set before deleting.", r.Kind.Name),
+		)
+		return
+	}
+
+	// An object that has already been deleted outside of Terraform is not an error
+	err := r.Kind.Delete(ctx, r.client, id)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s Has Dependent %s", r.Kind.Title, titleCase(r.Kind.Dependents)),
+			fmt.Sprintf("%s %d cannot be deleted while %s still reference it. Delete those %s first. If they are managed by Terraform, make sure they reference this %s through its id attribute (e.g. %s = <this resource>.id) so Terraform destroys them before it.\n\n%s",
+				r.Kind.Title, id, r.Kind.Dependents, r.Kind.Dependents, r.Kind.Name, r.Kind.ReferenceAttribute, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting "+r.Kind.Name,
+			fmt.Sprintf("Could not delete %s, unexpected error: %s", r.Kind.Name, err.Error()),
+		)
+	}
+}
+
+// ImportState imports the resource into Terraform state. The object can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	key, diags := identity.ImportKey(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var object *Object
+	var err error
+	if key.ID != 0 {
+		object, err = r.Kind.Get(ctx, r.client, key.ID)
+	} else {
+		object, err = r.Kind.FindBySlug(ctx, r.client, key.Slug)
+	}
+	if err == nil {
+		err = key.CheckWorkspace(object.WorkspaceID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing "+r.Kind.Name,
+			fmt.Sprintf("Could not find the %s to import: %s", r.Kind.Name, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), object.ID)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, object.WorkspaceID, object.Slug)...)
+}
+
+// titleCase capitalizes the first letter of s.
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
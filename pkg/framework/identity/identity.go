@@ -0,0 +1,127 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// Model is the resource identity shared by every Hightouch resource. Numeric
+// IDs differ between workspaces, but slugs are unique within a workspace and
+// are usually kept the same across environments.
+type Model struct {
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	Slug        types.String `tfsdk:"slug"`
+}
+
+// Schema is the identity schema shared by every Hightouch resource.
+var Schema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"workspace_id": identityschema.Int64Attribute{
+			Description:       "The ID of the workspace the object belongs to. Defaults to the workspace of the provider's API key when importing.",
+			OptionalForImport: true,
+		},
+		"slug": identityschema.StringAttribute{
+			Description:       "The slug of the object.",
+			RequiredForImport: true,
+		},
+	},
+}
+
+// ImportKey reads the key of the object to import, either from the import ID
+// or, for import blocks using identity, from the identity attributes.
+func ImportKey(ctx context.Context, req resource.ImportStateRequest) (helper.ImportKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ID != "" {
+		key, err := helper.ParseImportID(req.ID)
+		if err != nil {
+			diags.AddError("Invalid ID for Import", err.Error())
+		}
+		return key, diags
+	}
+
+	var identity Model
+	if req.Identity != nil {
+		diags.Append(req.Identity.Get(ctx, &identity)...)
+	}
+	if diags.HasError() {
+		return helper.ImportKey{}, diags
+	}
+	if identity.Slug.ValueString() == "" {
+		diags.AddAttributeError(path.Root("slug"), "Invalid Identity for Import", "The slug must be set to import by identity.")
+		return helper.ImportKey{}, diags
+	}
+
+	return helper.ImportKey{
+		Slug:        identity.Slug.ValueString(),
+		WorkspaceID: int(identity.WorkspaceID.ValueInt64()),
+	}, diags
+}
+
+// Set records the identity of an object on a response. It does nothing if
+// Terraform does not support resource identity, in which case identity is nil.
+func Set(ctx context.Context, identity *tfsdk.ResourceIdentity, workspaceID int, slug string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, Model{
+		WorkspaceID: types.Int64Value(int64(workspaceID)),
+		Slug:        types.StringValue(slug),
+	})
+}
+
+// ImportSource imports the source identified by the import ID or identity of
+// req into resp. A source of any type but sourceType is rejected, since each
+// typed source resource only manages sources of its own type.
+func ImportSource(
+	ctx context.Context,
+	client *hightouch.Client,
+	sourceType string,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	key, diags := ImportKey(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source *hightouch.HightouchSource
+	var err error
+	switch {
+	case key.ID != 0:
+		source, err = client.GetHightouchSourceOfType(ctx, sourceType, key.ID)
+	default:
+		source, err = client.FindHightouchSource(ctx, hightouch.ListOptions{Slug: key.Slug})
+		if err == nil && source.Type != sourceType {
+			err = &hightouch.SourceTypeError{ID: *source.ID, Type: source.Type, Want: sourceType}
+		}
+	}
+	if err == nil {
+		err = key.CheckWorkspace(source.WorkspaceID)
+	}
+
+	var typeErr *hightouch.SourceTypeError
+	if errors.As(err, &typeErr) {
+		resp.Diagnostics.AddError(
+			"Unexpected Source Type",
+			"Could not import the source: "+typeErr.Error()+". Import it into the resource for its type instead.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing source", "Could not find the source to import: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *source.ID)...)
+	resp.Diagnostics.Append(Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+}
@@ -0,0 +1,127 @@
+package jsontype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-hightouch/pkg/helper"
+)
+
+// NormalizedType is a string attribute type holding JSON. Values that differ
+// only in key order, whitespace or how numbers are written are semantically
+// equal, so re-marshaling an API response does not show up as a diff.
+// Top-level keys the API fills in with null are ignored as well.
+type NormalizedType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable name of the type.
+func (t NormalizedType) String() string {
+	return "jsontype.NormalizedType"
+}
+
+// ValueType returns the value type of the type.
+func (t NormalizedType) ValueType(_ context.Context) attr.Value {
+	return Normalized{}
+}
+
+// Equal reports whether o is a NormalizedType.
+func (t NormalizedType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString converts a string value to a Normalized value.
+func (t NormalizedType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Normalized{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a Normalized value.
+func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// Normalized is a JSON string value of NormalizedType.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedNull returns a null Normalized value.
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+// NewNormalizedValue returns a known Normalized value holding value.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the type of the value.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// Equal reports whether o is a Normalized value with exactly the same string.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether newValuable, usually read from the
+// API, holds the same JSON as the prior value v.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	equal, err := helper.JSONEqual(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", "Could not compare JSON values: "+err.Error())
+		return false, diags
+	}
+	return equal, diags
+}
+
+// ValidateAttribute checks that a configured value is valid JSON.
+func (v Normalized) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.\n\nGiven Value: "+v.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,106 @@
+package bigquery_source
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-hightouch/pkg/framework/authmethod"
+)
+
+// Values of the auth_method attribute, which are also the values of the
+// auth_method configuration key in the Hightouch API.
+const (
+	authMethodServiceAccount   = "service_account"
+	authMethodWorkloadIdentity = "workload_identity"
+)
+
+// workloadIdentityProviderRegexp matches the full resource name of a workload
+// identity pool provider.
+var workloadIdentityProviderRegexp = regexp.MustCompile(`^//iam\.googleapis\.com/projects/\d+/locations/global/workloadIdentityPools/[^/]+/providers/[^/]+$`)
+
+// serviceAccountEmailRegexp matches the email of a Google Cloud service account.
+var serviceAccountEmailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.iam\.gserviceaccount\.com$`)
+
+// authMethods lists the attributes of each auth method. Workload identity
+// federation needs no secret.
+var authMethods = authmethod.Methods{
+	Default: authMethodServiceAccount,
+	ByName: map[string]authmethod.Method{
+		authMethodServiceAccount: {
+			Required: []string{"service_account_json"},
+			Secrets:  []string{"service_account_json"},
+			Version:  "service_account_json_wo_version",
+		},
+		authMethodWorkloadIdentity: {
+			Required: []string{"workload_identity_provider", "service_account_email"},
+		},
+	},
+}
+
+// ValidateConfig checks that exactly the attributes of the configured
+// auth_method are set, and that a known service account key is valid JSON.
+func (r *BigQuerySourceResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config BigQuerySourceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(authMethods.Validate(config.AuthMethod, map[string]types.String{
+		"service_account_json":       config.ServiceAccountJSON,
+		"workload_identity_provider": config.WorkloadIdentityProvider,
+		"service_account_email":      config.ServiceAccountEmail,
+	})...)
+
+	if key := config.ServiceAccountJSON; !key.IsNull() && !key.IsUnknown() {
+		var parsed struct {
+			Type        string `json:"type"`
+			ClientEmail string `json:"client_email"`
+		}
+		if err := json.Unmarshal([]byte(key.ValueString()), &parsed); err != nil || parsed.Type != "service_account" || parsed.ClientEmail == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_account_json"),
+				"Invalid Service Account Key",
+				"service_account_json must be the JSON key of a service account, as downloaded from Google Cloud.",
+			)
+		}
+	}
+}
+
+// authConfiguration returns the configuration keys for the auth method in
+// plan. The write-only service account key is included only when sendSecrets
+// is true, and is then cleared if the source uses workload identity.
+func authConfiguration(
+	ctx context.Context,
+	config tfsdk.Config,
+	plan BigQuerySourceResourceModel,
+	sendSecrets bool,
+) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	configuration := map[string]interface{}{
+		"auth_method":                plan.AuthMethod.ValueString(),
+		"workload_identity_provider": plan.WorkloadIdentityProvider.ValueStringPointer(),
+		"service_account_email":      plan.ServiceAccountEmail.ValueStringPointer(),
+	}
+	if !sendSecrets {
+		return configuration, diags
+	}
+
+	secrets, diags := authMethods.Secrets(ctx, config, plan.AuthMethod.ValueString())
+	if diags.HasError() {
+		return nil, diags
+	}
+	for key, value := range secrets {
+		configuration[key] = value
+	}
+	return configuration, diags
+}
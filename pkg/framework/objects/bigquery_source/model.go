@@ -0,0 +1,40 @@
+package bigquery_source
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BigQuerySourceResourceModel maps the resource schema data for a BigQuery source in Hightouch.
+type BigQuerySourceResourceModel struct {
+	ID                          types.Int64  `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Slug                        types.String `tfsdk:"slug"`
+	Type                        types.String `tfsdk:"type"`
+	Project                     types.String `tfsdk:"project"`
+	Location                    types.String `tfsdk:"location"`
+	AuthMethod                  types.String `tfsdk:"auth_method"`
+	ServiceAccountJSON          types.String `tfsdk:"service_account_json"`
+	ServiceAccountJSONWOVersion types.Int64  `tfsdk:"service_account_json_wo_version"`
+	WorkloadIdentityProvider    types.String `tfsdk:"workload_identity_provider"`
+	ServiceAccountEmail         types.String `tfsdk:"service_account_email"`
+	WorkspaceID                 types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt                   types.String `tfsdk:"created_at"`
+	UpdatedAt                   types.String `tfsdk:"updated_at"`
+}
+
+// BigQuerySourceDataSourceModel maps the data source schema data for a
+// BigQuery source. It has no service account key, which is never read back.
+type BigQuerySourceDataSourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Slug                     types.String `tfsdk:"slug"`
+	Type                     types.String `tfsdk:"type"`
+	Project                  types.String `tfsdk:"project"`
+	Location                 types.String `tfsdk:"location"`
+	AuthMethod               types.String `tfsdk:"auth_method"`
+	WorkloadIdentityProvider types.String `tfsdk:"workload_identity_provider"`
+	ServiceAccountEmail      types.String `tfsdk:"service_account_email"`
+	WorkspaceID              types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+}
@@ -0,0 +1,309 @@
+package bigquery_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/identity"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// BigQuerySourceResource is the resource implementation.
+type BigQuerySourceResource struct {
+	client *hightouch.Client
+}
+
+// NewBigQuerySourceResource is a helper function to simplify resource server allocation.
+func NewBigQuerySourceResource() resource.Resource {
+	return &BigQuerySourceResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *BigQuerySourceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_bigquery_source"
+}
+
+// Schema defines the schema for the resource.
+func (r *BigQuerySourceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = BigQuerySourceResourceSchema
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *BigQuerySourceResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
+// Configure adds the hightouch_resources configured client to the resource.
+func (r *BigQuerySourceResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial state.
+func (r *BigQuerySourceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Retrieve values from plan
+	var plan BigQuerySourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert configuration from Terraform types to Go types
+	config := make(map[string]interface{})
+	config["project"] = plan.Project.ValueString()
+	config["location"] = plan.Location.ValueStringPointer()
+
+	auth, diags := authConfiguration(ctx, req.Config, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range auth {
+		config[key] = value
+	}
+
+	// Call the API to create the source
+	source, err := r.client.CreateHightouchSource(
+		ctx,
+		plan.Name.ValueString(),
+		plan.Slug.ValueString(),
+		plan.Type.ValueString(),
+		config,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating source", "Could not create source, unexpected error: "+err.Error())
+		return
+	}
+
+	// Map response body to the plan
+	sourceID := *source.ID
+	plan.ID = types.Int64Value(int64(sourceID))
+	plan.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	plan.CreatedAt = types.StringValue(source.CreatedAt.String())
+	plan.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the resource state with the latest data.
+func (r *BigQuerySourceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state BigQuerySourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed source from Hightouch API
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
+		return
+	}
+	source, err := r.client.GetHightouchSource(ctx, sourceID)
+	if hightouch.IsNotFound(err) {
+		// The source was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, "BigQuery source no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"source_id": sourceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", "Could not read source, unexpected error: "+err.Error())
+		return
+	}
+
+	workspaceID := int64(source.WorkspaceID)
+	if workspaceID == 0 {
+		resp.Diagnostics.AddError("Invalid Workspace ID", "The workspace ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
+		return
+	}
+
+	// Overwrite state with refreshed values
+	state.ID = types.Int64Value(int64(sourceID))
+	state.Name = types.StringValue(source.Name)
+	state.Slug = types.StringValue(source.Slug)
+	state.Type = types.StringValue(source.Type)
+	state.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+	state.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	state.CreatedAt = types.StringValue(source.CreatedAt.String())
+
+	// Convert configuration from Go types to Terraform types. The service
+	// account key is write-only and never read back.
+	configuration, err := hightouch.ParseBigQueryConfiguration(source.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", fmt.Sprintf("Could not decode the configuration of source %d: %s", sourceID, err.Error()))
+		return
+	}
+	state.Project = types.StringValue(configuration.Project)
+	state.Location = types.StringPointerValue(configuration.Location)
+	state.AuthMethod = types.StringValue(authMethods.Name(configuration.AuthMethod))
+	state.WorkloadIdentityProvider = types.StringPointerValue(configuration.WorkloadIdentityProvider)
+	state.ServiceAccountEmail = types.StringPointerValue(configuration.ServiceAccountEmail)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated state.
+func (r *BigQuerySourceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state BigQuerySourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before updating. Please ensure the resource has been created successfully before attempting to update it.")
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating BigQuery source", map[string]interface{}{
+		"source_id": sourceID,
+	})
+
+	// Convert configuration from Terraform types to Go types
+	config := make(map[string]interface{})
+	config["project"] = plan.Project.ValueString()
+	config["location"] = plan.Location.ValueStringPointer()
+
+	// The API keeps the stored key when it is omitted, so it is only sent when
+	// the auth method or service_account_json_wo_version changes
+	sendSecrets, diags := authMethods.SecretsChanged(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	auth, diags := authConfiguration(ctx, req.Config, plan, sendSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range auth {
+		config[key] = value
+	}
+
+	// Call the API to update the source
+	source, err := r.client.UpdateHightouchSource(
+		ctx,
+		sourceID,
+		plan.Name.ValueString(),
+		config,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating source", "Could not update source, unexpected error: "+err.Error())
+		return
+	}
+
+	// Update the plan with the response from the API
+	plan.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+	plan.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	plan.ID = types.Int64Value(int64(sourceID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
+}
+
+// Delete deletes the resource from the remote API.
+func (r *BigQuerySourceResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state BigQuerySourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before deleting.")
+		return
+	}
+
+	// A source that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchSource(ctx, sourceID)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			"Source Has Dependent Models",
+			fmt.Sprintf("Source %d cannot be deleted while models still reference it. Delete those models first. If they are managed by Terraform, make sure they reference this source through its id attribute (e.g. source_id = <this resource>.id) so Terraform destroys them before it.\n\n%s", sourceID, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting source", "Could not delete source, unexpected error: "+err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state. The source can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
+func (r *BigQuerySourceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	identity.ImportSource(ctx, r.client, sourceType, req, resp)
+}
@@ -0,0 +1,119 @@
+package bigquery_source_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+const (
+	testAccServiceAccountKey        = `{"type": "service_account", "project_id": "analytics-prod", "client_email": "hightouch@analytics-prod.iam.gserviceaccount.com"}`
+	testAccRotatedServiceAccountKey = `{"type": "service_account", "project_id": "analytics-prod", "client_email": "hightouch-2@analytics-prod.iam.gserviceaccount.com"}`
+	testAccWorkloadIdentityProvider = "//iam.googleapis.com/projects/123456/locations/global/workloadIdentityPools/hightouch/providers/hightouch"
+)
+
+func TestAccBigQuerySourceResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_bigquery_source", server.HasSource),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(`
+  service_account_json = "{\"type\": \"authorized_user\"}"
+`),
+				ExpectError: regexp.MustCompile(`Invalid Service Account Key`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(`
+  auth_method           = "workload_identity"
+  service_account_email = "hightouch@analytics-prod.iam.gserviceaccount.com"
+`),
+				ExpectError: regexp.MustCompile(`workload_identity_provider is required when auth_method is "workload_identity"`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(fmt.Sprintf(`
+  location                        = "EU"
+  service_account_json            = %q
+  service_account_json_wo_version = 1
+`, testAccServiceAccountKey)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_bigquery_source.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "type", "bigquery"),
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "project", "analytics-prod"),
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "location", "EU"),
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "auth_method", "service_account"),
+					resource.TestCheckNoResourceAttr("hightouch_bigquery_source.test", "service_account_json"),
+					acctest.CheckSourceConfiguration(server, "hightouch_bigquery_source.test", "service_account_json", testAccServiceAccountKey),
+				),
+			},
+			{
+				ResourceName:            "hightouch_bigquery_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_account_json_wo_version"},
+			},
+			{
+				// A new key is not sent until service_account_json_wo_version changes.
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(fmt.Sprintf(`
+  service_account_json            = %q
+  service_account_json_wo_version = 1
+`, testAccRotatedServiceAccountKey)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hightouch_bigquery_source.test", "location"),
+					acctest.CheckSourceConfiguration(server, "hightouch_bigquery_source.test", "service_account_json", testAccServiceAccountKey),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(fmt.Sprintf(`
+  service_account_json            = %q
+  service_account_json_wo_version = 2
+`, testAccRotatedServiceAccountKey)),
+				Check: acctest.CheckSourceConfiguration(server, "hightouch_bigquery_source.test", "service_account_json", testAccRotatedServiceAccountKey),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccBigQuerySourceConfig(fmt.Sprintf(`
+  auth_method                = "workload_identity"
+  workload_identity_provider = %q
+  service_account_email      = "hightouch@analytics-prod.iam.gserviceaccount.com"
+`, testAccWorkloadIdentityProvider)) + `
+data "hightouch_bigquery_source" "test" {
+  slug = hightouch_bigquery_source.test.slug
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "auth_method", "workload_identity"),
+					resource.TestCheckResourceAttr("hightouch_bigquery_source.test", "workload_identity_provider", testAccWorkloadIdentityProvider),
+					resource.TestCheckResourceAttrPair("data.hightouch_bigquery_source.test", "id", "hightouch_bigquery_source.test", "id"),
+					resource.TestCheckResourceAttr("data.hightouch_bigquery_source.test", "auth_method", "workload_identity"),
+					resource.TestCheckResourceAttr("data.hightouch_bigquery_source.test", "service_account_email", "hightouch@analytics-prod.iam.gserviceaccount.com"),
+					resource.TestCheckNoResourceAttr("data.hightouch_bigquery_source.test", "service_account_json"),
+					acctest.CheckSourceConfiguration(server, "hightouch_bigquery_source.test", "service_account_json", nil),
+				),
+			},
+			{
+				ResourceName:      "hightouch_bigquery_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigQuerySourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "hightouch_bigquery_source" "test" {
+  name    = "BigQuery"
+  slug    = "acc-bigquery"
+  project = "analytics-prod"
+  %s
+}
+`, settings)
+}
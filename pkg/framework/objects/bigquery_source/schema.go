@@ -0,0 +1,153 @@
+package bigquery_source
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// sourceType is the type of the sources managed by this package.
+const sourceType = "bigquery"
+
+var BigQuerySourceResourceSchema = schema.Schema{
+	Description: "Represents a Hightouch BigQuery Source.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the source.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the source.",
+			Required:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "The slug of the source. Slugs cannot be changed in place, so changing it replaces the source.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the source, 'bigquery'.",
+			Computed:    true,
+			Default:     stringdefault.StaticString(sourceType),
+		},
+		"project": schema.StringAttribute{
+			Description: "ID of the Google Cloud project that runs queries and is billed for them.",
+			Required:    true,
+		},
+		"location": schema.StringAttribute{
+			Description: "Location of the datasets, such as `US`, `EU` or `europe-west2`. Defaults to the `US` multi-region.",
+			Optional:    true,
+		},
+		"auth_method": schema.StringAttribute{
+			Description: "How Hightouch authenticates to BigQuery: `service_account` (the default), with a service account key, or `workload_identity`, with workload identity federation and no long-lived key.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(authMethodServiceAccount),
+			Validators: []validator.String{
+				stringvalidator.OneOf(authMethodServiceAccount, authMethodWorkloadIdentity),
+			},
+		},
+		"service_account_json": schema.StringAttribute{
+			Description: "JSON key of the service account, required when auth_method is `service_account`. Write-only: it is never stored in state, so change service_account_json_wo_version to send a new key. Requires Terraform 1.11 or later.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"service_account_json_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current service_account_json to Hightouch, for example after rotating the key.",
+			Optional:    true,
+		},
+		"workload_identity_provider": schema.StringAttribute{
+			Description: "Full resource name of the workload identity pool provider that trusts Hightouch, such as `//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/hightouch/providers/hightouch`. Required when auth_method is `workload_identity`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(workloadIdentityProviderRegexp, "must be the full resource name of a workload identity pool provider"),
+			},
+		},
+		"service_account_email": schema.StringAttribute{
+			Description: "Email of the service account Hightouch impersonates through workload identity federation. Required when auth_method is `workload_identity`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(serviceAccountEmailRegexp, "must be a service account email ending in .iam.gserviceaccount.com"),
+			},
+		},
+		"workspace_id": schema.Int64Attribute{
+			Description: "The ID of the workspace that the source belongs to.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The timestamp when the source was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The timestamp when the source was last updated.",
+			Computed:    true,
+		},
+	},
+}
+
+var BigQuerySourceDataSourceSchema = datasourceschema.Schema{
+	Description: "Fetches information about a Hightouch BigQuery Source.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"id": datasourceschema.Int64Attribute{
+			Description: "The ID of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"name": datasourceschema.StringAttribute{
+			Description: "The name of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up; the lookup fails if several sources share the name.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"slug": datasourceschema.StringAttribute{
+			Description: "The slug of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"type": datasourceschema.StringAttribute{
+			Description: "The type of the source.",
+			Computed:    true,
+		},
+		"project": datasourceschema.StringAttribute{
+			Description: "ID of the Google Cloud project.",
+			Computed:    true,
+		},
+		"location": datasourceschema.StringAttribute{
+			Description: "Location of the datasets.",
+			Computed:    true,
+		},
+		"auth_method": datasourceschema.StringAttribute{
+			Description: "How Hightouch authenticates to BigQuery: `service_account` or `workload_identity`.",
+			Computed:    true,
+		},
+		"workload_identity_provider": datasourceschema.StringAttribute{
+			Description: "Full resource name of the workload identity pool provider, when auth_method is `workload_identity`.",
+			Computed:    true,
+		},
+		"service_account_email": datasourceschema.StringAttribute{
+			Description: "Email of the impersonated service account, when auth_method is `workload_identity`.",
+			Computed:    true,
+		},
+		"workspace_id": datasourceschema.Int64Attribute{
+			Description: "The ID of the workspace that the source belongs to.",
+			Computed:    true,
+		},
+		"created_at": datasourceschema.StringAttribute{
+			Description: "The timestamp when the source was created.",
+			Computed:    true,
+		},
+		"updated_at": datasourceschema.StringAttribute{
+			Description: "The timestamp when the source was last updated.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,101 @@
+package databricks_source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-hightouch/pkg/framework/authmethod"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// Values of the auth_method attribute, which are also the values of the
+// auth_method configuration key in the Hightouch API.
+const (
+	authMethodAccessToken = "access_token"
+	authMethodOAuthM2M    = "oauth_m2m"
+)
+
+// hostRegexp matches a hostname without a scheme, port or path.
+var hostRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?$`)
+
+// httpPathRegexp matches the HTTP path of a SQL warehouse or cluster.
+var httpPathRegexp = regexp.MustCompile(`^/sql/\S+$`)
+
+// authMethods lists the attributes of each auth method.
+var authMethods = authmethod.Methods{
+	Default: authMethodAccessToken,
+	ByName: map[string]authmethod.Method{
+		authMethodAccessToken: {
+			Required: []string{"access_token"},
+			Secrets:  []string{"access_token"},
+			Version:  "access_token_wo_version",
+		},
+		authMethodOAuthM2M: {
+			Required: []string{"oauth_client_id", "oauth_client_secret"},
+			Secrets:  []string{"oauth_client_secret"},
+			Version:  "oauth_client_secret_wo_version",
+		},
+	},
+}
+
+// ValidateConfig checks that exactly the attributes of the configured
+// auth_method are set.
+func (r *DatabricksSourceResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config DatabricksSourceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(authMethods.Validate(config.AuthMethod, map[string]types.String{
+		"access_token":        config.AccessToken,
+		"oauth_client_id":     config.OAuthClientID,
+		"oauth_client_secret": config.OAuthClientSecret,
+	})...)
+}
+
+// authConfiguration returns the configuration keys for the auth method in
+// plan. Write-only secrets are included only when sendSecrets is true; the
+// secrets of the other method are then cleared.
+func authConfiguration(
+	ctx context.Context,
+	config tfsdk.Config,
+	plan DatabricksSourceResourceModel,
+	sendSecrets bool,
+) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	method := plan.AuthMethod.ValueString()
+
+	configuration := map[string]interface{}{
+		"auth_method":     method,
+		"oauth_client_id": plan.OAuthClientID.ValueStringPointer(),
+	}
+	if !sendSecrets {
+		return configuration, diags
+	}
+
+	secrets, diags := authMethods.Secrets(ctx, config, method)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for key, value := range secrets {
+		configuration[key] = value
+	}
+	return configuration, diags
+}
+
+// oauthClientID returns the OAuth client ID of a source, which is only
+// meaningful when it uses OAuth.
+func oauthClientID(configuration hightouch.DatabricksConfiguration) types.String {
+	if authMethods.Name(configuration.AuthMethod) != authMethodOAuthM2M {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(configuration.OAuthClientID)
+}
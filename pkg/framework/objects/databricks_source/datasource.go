@@ -0,0 +1,141 @@
+package databricks_source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// DatabricksSourceDataSource is the data source implementation.
+type DatabricksSourceDataSource struct {
+	client *hightouch.Client
+}
+
+// NewDatabricksSourceDataSource is a helper function to simplify data source server allocation.
+func NewDatabricksSourceDataSource() datasource.DataSource {
+	return &DatabricksSourceDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DatabricksSourceDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_databricks_source"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabricksSourceDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = DatabricksSourceDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabricksSourceDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ConfigValidators requires exactly one of id, slug or name to be configured.
+func (d *DatabricksSourceDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabricksSourceDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config DatabricksSourceDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look the source up by whichever of id, slug or name is configured
+	var source *hightouch.HightouchSource
+	var err error
+	if !config.ID.IsNull() {
+		source, err = d.client.GetHightouchSourceOfType(ctx, sourceType, int(config.ID.ValueInt64()))
+	} else {
+		source, err = d.client.FindHightouchSourceOfType(ctx, sourceType, hightouch.ListOptions{
+			Name: config.Name.ValueString(),
+			Slug: config.Slug.ValueString(),
+		})
+	}
+
+	var lookupErr *hightouch.LookupError
+	if errors.As(err, &lookupErr) {
+		resp.Diagnostics.AddAttributeError(path.Root(lookupErr.Field), "Source Lookup Failed", lookupErr.Error())
+		return
+	}
+	var typeErr *hightouch.SourceTypeError
+	if errors.As(err, &typeErr) {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Source Lookup Failed", typeErr.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", "Could not read source, unexpected error: "+err.Error())
+		return
+	}
+
+	// Map API response to Terraform state
+	config.ID = types.Int64Value(int64(*source.ID))
+	config.Name = types.StringValue(source.Name)
+	config.Slug = types.StringValue(source.Slug)
+	config.Type = types.StringValue(source.Type)
+	config.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
+	config.CreatedAt = types.StringValue(source.CreatedAt.String())
+	config.UpdatedAt = types.StringValue(source.UpdatedAt.String())
+
+	configuration, err := hightouch.ParseDatabricksConfiguration(source.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", fmt.Sprintf("Could not decode the configuration of source %d: %s", *source.ID, err.Error()))
+		return
+	}
+	config.Host = types.StringValue(configuration.Host)
+	config.HTTPPath = types.StringValue(configuration.HTTPPath)
+	config.Catalog = types.StringPointerValue(configuration.Catalog)
+	config.Schema = types.StringPointerValue(configuration.Schema)
+	config.AuthMethod = types.StringValue(authMethods.Name(configuration.AuthMethod))
+	config.OAuthClientID = oauthClientID(configuration)
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,45 @@
+package databricks_source
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatabricksSourceResourceModel maps the resource schema data for a Databricks source in Hightouch.
+type DatabricksSourceResourceModel struct {
+	ID                         types.Int64  `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	Slug                       types.String `tfsdk:"slug"`
+	Type                       types.String `tfsdk:"type"`
+	Host                       types.String `tfsdk:"host"`
+	HTTPPath                   types.String `tfsdk:"http_path"`
+	Catalog                    types.String `tfsdk:"catalog"`
+	Schema                     types.String `tfsdk:"schema"`
+	AuthMethod                 types.String `tfsdk:"auth_method"`
+	AccessToken                types.String `tfsdk:"access_token"`
+	AccessTokenWOVersion       types.Int64  `tfsdk:"access_token_wo_version"`
+	OAuthClientID              types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret          types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecretWOVersion types.Int64  `tfsdk:"oauth_client_secret_wo_version"`
+	WorkspaceID                types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt                  types.String `tfsdk:"created_at"`
+	UpdatedAt                  types.String `tfsdk:"updated_at"`
+}
+
+// DatabricksSourceDataSourceModel maps the data source schema data for a
+// Databricks source. It has no token or client secret, which are never read
+// back.
+type DatabricksSourceDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Slug          types.String `tfsdk:"slug"`
+	Type          types.String `tfsdk:"type"`
+	Host          types.String `tfsdk:"host"`
+	HTTPPath      types.String `tfsdk:"http_path"`
+	Catalog       types.String `tfsdk:"catalog"`
+	Schema        types.String `tfsdk:"schema"`
+	AuthMethod    types.String `tfsdk:"auth_method"`
+	OAuthClientID types.String `tfsdk:"oauth_client_id"`
+	WorkspaceID   types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
@@ -0,0 +1,121 @@
+package databricks_source_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccDatabricksSourceResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_databricks_source", server.HasSource),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host         = "https://dbc-1234.cloud.databricks.com"
+  access_token = "dapi-secret"
+`),
+				ExpectError: regexp.MustCompile(`must be a hostname, without a scheme or path`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host            = "dbc-1234.cloud.databricks.com"
+  access_token    = "dapi-secret"
+  oauth_client_id = "hightouch-sp"
+`),
+				ExpectError: regexp.MustCompile(`oauth_client_id cannot be set when auth_method is "access_token"`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host                    = "dbc-1234.cloud.databricks.com"
+  catalog                 = "main"
+  schema                  = "analytics"
+  access_token            = "dapi-secret"
+  access_token_wo_version = 1
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_databricks_source.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "type", "databricks"),
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "http_path", "/sql/1.0/warehouses/abc123"),
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "catalog", "main"),
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "auth_method", "access_token"),
+					resource.TestCheckNoResourceAttr("hightouch_databricks_source.test", "access_token"),
+					acctest.CheckSourceConfiguration(server, "hightouch_databricks_source.test", "access_token", "dapi-secret"),
+				),
+			},
+			{
+				ResourceName:            "hightouch_databricks_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_token_wo_version"},
+			},
+			{
+				// A new token is not sent until access_token_wo_version changes.
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host                    = "dbc-1234.cloud.databricks.com"
+  access_token            = "dapi-rotated"
+  access_token_wo_version = 1
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hightouch_databricks_source.test", "catalog"),
+					acctest.CheckSourceConfiguration(server, "hightouch_databricks_source.test", "access_token", "dapi-secret"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host                    = "dbc-1234.cloud.databricks.com"
+  access_token            = "dapi-rotated"
+  access_token_wo_version = 2
+`),
+				Check: acctest.CheckSourceConfiguration(server, "hightouch_databricks_source.test", "access_token", "dapi-rotated"),
+			},
+			{
+				// Switching to OAuth sends the client secret and clears the token.
+				Config: acctest.ProviderConfig(server) + testAccDatabricksSourceConfig(`
+  host                = "dbc-1234.cloud.databricks.com"
+  auth_method         = "oauth_m2m"
+  oauth_client_id     = "hightouch-sp"
+  oauth_client_secret = "sp-secret"
+`) + `
+data "hightouch_databricks_source" "test" {
+  slug = hightouch_databricks_source.test.slug
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "auth_method", "oauth_m2m"),
+					resource.TestCheckResourceAttr("hightouch_databricks_source.test", "oauth_client_id", "hightouch-sp"),
+					resource.TestCheckResourceAttrPair("data.hightouch_databricks_source.test", "id", "hightouch_databricks_source.test", "id"),
+					resource.TestCheckResourceAttr("data.hightouch_databricks_source.test", "oauth_client_id", "hightouch-sp"),
+					resource.TestCheckNoResourceAttr("data.hightouch_databricks_source.test", "oauth_client_secret"),
+					acctest.CheckSourceConfiguration(server, "hightouch_databricks_source.test", "oauth_client_secret", "sp-secret"),
+					acctest.CheckSourceConfiguration(server, "hightouch_databricks_source.test", "access_token", nil),
+				),
+			},
+			{
+				ResourceName:      "hightouch_databricks_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDatabricksSourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "hightouch_databricks_source" "test" {
+  name      = "Lakehouse"
+  slug      = "acc-databricks"
+  http_path = "/sql/1.0/warehouses/abc123"
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,171 @@
+package databricks_source
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// sourceType is the type of the sources managed by this package.
+const sourceType = "databricks"
+
+var DatabricksSourceResourceSchema = schema.Schema{
+	Description: "Represents a Hightouch Databricks Source.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the source.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the source.",
+			Required:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "The slug of the source. Slugs cannot be changed in place, so changing it replaces the source.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the source, 'databricks'.",
+			Computed:    true,
+			Default:     stringdefault.StaticString(sourceType),
+		},
+		"host": schema.StringAttribute{
+			Description: "Hostname of the Databricks workspace, such as `dbc-1234abcd-5678.cloud.databricks.com`, without `https://`.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(hostRegexp, "must be a hostname, without a scheme or path"),
+			},
+		},
+		"http_path": schema.StringAttribute{
+			Description: "HTTP path of the SQL warehouse or cluster, such as `/sql/1.0/warehouses/abc123`, from its connection details.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(httpPathRegexp, "must be the HTTP path of a SQL warehouse or cluster, starting with /sql/"),
+			},
+		},
+		"catalog": schema.StringAttribute{
+			Description: "Default Unity Catalog catalog. Defaults to the default catalog of the workspace.",
+			Optional:    true,
+		},
+		"schema": schema.StringAttribute{
+			Description: "Default schema for queries that do not qualify table names.",
+			Optional:    true,
+		},
+		"auth_method": schema.StringAttribute{
+			Description: "How Hightouch authenticates to Databricks: `access_token` (the default), with a personal access token, or `oauth_m2m`, with the OAuth client credentials of a service principal.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(authMethodAccessToken),
+			Validators: []validator.String{
+				stringvalidator.OneOf(authMethodAccessToken, authMethodOAuthM2M),
+			},
+		},
+		"access_token": schema.StringAttribute{
+			Description: "Personal access token, required when auth_method is `access_token`. Write-only: it is never stored in state, so change access_token_wo_version to send a new token. Requires Terraform 1.11 or later.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"access_token_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current access_token to Hightouch, for example after rotating it.",
+			Optional:    true,
+		},
+		"oauth_client_id": schema.StringAttribute{
+			Description: "Client ID (application ID) of the service principal, required when auth_method is `oauth_m2m`.",
+			Optional:    true,
+		},
+		"oauth_client_secret": schema.StringAttribute{
+			Description: "OAuth secret of the service principal, required when auth_method is `oauth_m2m`. Write-only: change oauth_client_secret_wo_version to send a new secret.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"oauth_client_secret_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current oauth_client_secret to Hightouch.",
+			Optional:    true,
+		},
+		"workspace_id": schema.Int64Attribute{
+			Description: "The ID of the workspace that the source belongs to.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The timestamp when the source was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The timestamp when the source was last updated.",
+			Computed:    true,
+		},
+	},
+}
+
+var DatabricksSourceDataSourceSchema = datasourceschema.Schema{
+	Description: "Fetches information about a Hightouch Databricks Source.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"id": datasourceschema.Int64Attribute{
+			Description: "The ID of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"name": datasourceschema.StringAttribute{
+			Description: "The name of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up; the lookup fails if several sources share the name.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"slug": datasourceschema.StringAttribute{
+			Description: "The slug of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"type": datasourceschema.StringAttribute{
+			Description: "The type of the source.",
+			Computed:    true,
+		},
+		"host": datasourceschema.StringAttribute{
+			Description: "Hostname of the Databricks workspace.",
+			Computed:    true,
+		},
+		"http_path": datasourceschema.StringAttribute{
+			Description: "HTTP path of the SQL warehouse or cluster.",
+			Computed:    true,
+		},
+		"catalog": datasourceschema.StringAttribute{
+			Description: "Default catalog.",
+			Computed:    true,
+		},
+		"schema": datasourceschema.StringAttribute{
+			Description: "Default schema.",
+			Computed:    true,
+		},
+		"auth_method": datasourceschema.StringAttribute{
+			Description: "How Hightouch authenticates to Databricks: `access_token` or `oauth_m2m`.",
+			Computed:    true,
+		},
+		"oauth_client_id": datasourceschema.StringAttribute{
+			Description: "Client ID of the service principal, when auth_method is `oauth_m2m`.",
+			Computed:    true,
+		},
+		"workspace_id": datasourceschema.Int64Attribute{
+			Description: "The ID of the workspace that the source belongs to.",
+			Computed:    true,
+		},
+		"created_at": datasourceschema.StringAttribute{
+			Description: "The timestamp when the source was created.",
+			Computed:    true,
+		},
+		"updated_at": datasourceschema.StringAttribute{
+			Description: "The timestamp when the source was last updated.",
+			Computed:    true,
+		},
+	},
+}
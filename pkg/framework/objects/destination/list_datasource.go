@@ -0,0 +1,118 @@
+package destination
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// DestinationsDataSource is the data source implementation for listing destinations.
+type DestinationsDataSource struct {
+	client *hightouch.Client
+}
+
+// NewDestinationsDataSource is a helper function to simplify data source server allocation.
+func NewDestinationsDataSource() datasource.DataSource {
+	return &DestinationsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DestinationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_destinations"
+}
+
+// Schema defines the schema for the data source.
+func (d *DestinationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = DestinationsDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DestinationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DestinationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config DestinationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := helper.NewNameFilter(config.NameRegex.ValueString(), config.SlugPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		return
+	}
+
+	destinations, err := d.client.ListHightouchDestinations(ctx, hightouch.ListOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing destinations", "Could not list destinations, unexpected error: "+err.Error())
+		return
+	}
+
+	config.Destinations = []DestinationSummaryModel{}
+	for _, destination := range destinations {
+		if !filter.Match(destination.Name, destination.Slug) {
+			continue
+		}
+		if !config.Type.IsNull() && destination.Type != config.Type.ValueString() {
+			continue
+		}
+
+		config.Destinations = append(config.Destinations, DestinationSummaryModel{
+			ID:          types.Int64Value(int64(*destination.ID)),
+			Name:        types.StringValue(destination.Name),
+			Slug:        types.StringValue(destination.Slug),
+			Type:        types.StringValue(destination.Type),
+			WorkspaceID: types.Int64Value(int64(destination.WorkspaceID)),
+			CreatedAt:   types.StringValue(destination.CreatedAt.String()),
+			UpdatedAt:   types.StringValue(destination.UpdatedAt.String()),
+		})
+	}
+
+	// The API does not promise an order, so sort by ID for a stable list
+	slices.SortFunc(config.Destinations, func(a, b DestinationSummaryModel) int {
+		return cmp.Compare(a.ID.ValueInt64(), b.ID.ValueInt64())
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,28 @@
+package destination
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/generic"
+)
+
+// DestinationResourceModel maps the resource schema data for a destination of any type in Hightouch.
+type DestinationResourceModel = generic.Model
+
+// DestinationsDataSourceModel maps the schema data for the hightouch_destinations data source.
+type DestinationsDataSourceModel struct {
+	NameRegex    types.String              `tfsdk:"name_regex"`
+	SlugPrefix   types.String              `tfsdk:"slug_prefix"`
+	Type         types.String              `tfsdk:"type"`
+	Destinations []DestinationSummaryModel `tfsdk:"destinations"`
+}
+
+// DestinationSummaryModel maps a single destination returned by the hightouch_destinations data source.
+type DestinationSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Type        types.String `tfsdk:"type"`
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
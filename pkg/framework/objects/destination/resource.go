@@ -0,0 +1,75 @@
+package destination
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-hightouch/pkg/framework/generic"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// DestinationResource is the resource implementation. Everything but its name and
+// schema is shared with hightouch_source.
+type DestinationResource struct {
+	generic.Resource
+}
+
+// NewDestinationResource is a helper function to simplify resource server allocation.
+func NewDestinationResource() resource.Resource {
+	return &DestinationResource{generic.Resource{Kind: destinationKind}}
+}
+
+// Metadata returns the resource type name.
+func (r *DestinationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_destination"
+}
+
+// Schema defines the schema for the resource.
+func (r *DestinationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = DestinationResourceSchema
+}
+
+var destinationKind = generic.Kind{
+	Name:               "destination",
+	Title:              "Destination",
+	Dependents:         "syncs",
+	ReferenceAttribute: "destination_id",
+	Get: func(ctx context.Context, client *hightouch.Client, id int) (*generic.Object, error) {
+		return fromDestination(client.GetHightouchDestination(ctx, id))
+	},
+	FindBySlug: func(ctx context.Context, client *hightouch.Client, slug string) (*generic.Object, error) {
+		return fromDestination(client.FindHightouchDestination(ctx, hightouch.ListOptions{Slug: slug}))
+	},
+	Create: func(ctx context.Context, client *hightouch.Client, name, slug, destinationType string, configuration map[string]interface{}) (*generic.Object, error) {
+		return fromDestination(client.CreateHightouchDestination(ctx, name, slug, destinationType, configuration))
+	},
+	Update: func(ctx context.Context, client *hightouch.Client, id int, name string, configuration map[string]interface{}) (*generic.Object, error) {
+		return fromDestination(client.UpdateHightouchDestination(ctx, id, name, configuration))
+	},
+	Delete: func(ctx context.Context, client *hightouch.Client, id int) error {
+		return client.DeleteHightouchDestination(ctx, id)
+	},
+}
+
+func fromDestination(destination *hightouch.HightouchDestination, err error) (*generic.Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &generic.Object{
+		ID:            *destination.ID,
+		Name:          destination.Name,
+		Slug:          destination.Slug,
+		Type:          destination.Type,
+		WorkspaceID:   destination.WorkspaceID,
+		CreatedAt:     destination.CreatedAt,
+		UpdatedAt:     destination.UpdatedAt,
+		Configuration: destination.Configuration,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package destination_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccDestinationResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	var destinationID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_destination", server.HasDestination),
+		// Credentials are a write-only attribute.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccDestinationConfig("Braze", "braze-key-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_destination.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_destination.test", "type", "braze"),
+					resource.TestCheckNoResourceAttr("hightouch_destination.test", "credentials"),
+					acctest.StoreID("hightouch_destination.test", &destinationID),
+					acctest.CheckDestinationConfiguration(server, "hightouch_destination.test", "api_key", "braze-key-1"),
+				),
+			},
+			{
+				// The imported configuration has every key the API returns
+				// except the API key.
+				ResourceName:            "hightouch_destination.test",
+				ImportState:             true,
+				ImportStateId:           "slug:acc-braze",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_version"},
+			},
+			{
+				// Keys added by the API are not drift.
+				PreConfig: func() {
+					server.SetDestinationConfiguration(destinationID, map[string]interface{}{"region": "US-01"})
+				},
+				Config:   acctest.ProviderConfig(server) + testAccDestinationConfig("Braze", "braze-key-1", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccDestinationConfig("Braze (EU)", "braze-key-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_destination.test", "name", "Braze (EU)"),
+					acctest.CheckDestinationConfiguration(server, "hightouch_destination.test", "api_key", "braze-key-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDestinationConfig(name, apiKey string, credentialsVersion int) string {
+	return fmt.Sprintf(`
+resource "hightouch_destination" "test" {
+  name = %q
+  slug = "acc-braze"
+  type = "braze"
+
+  configuration = jsonencode({
+    endpoint = "https://rest.iad-01.braze.com"
+  })
+
+  credentials = {
+    api_key = %q
+  }
+  credentials_version = %d
+}
+`, name, apiKey, credentialsVersion)
+}
@@ -0,0 +1,123 @@
+package destination
+
+import (
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
+)
+
+var DestinationResourceSchema = schema.Schema{
+	Description: "Represents a Hightouch Destination of any type. Non-secret settings go in configuration and secrets in the write-only credentials attribute.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the destination.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the destination.",
+			Required:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "The slug of the destination. Slugs cannot be changed in place, so changing it replaces the destination.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the destination (e.g., 'salesforce', 'hubspot', 'braze', 'webhook', 's3'). Changing it replaces the destination.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"configuration": schema.StringAttribute{
+			Description: "JSON object with the non-secret configuration of the destination, as accepted by the Hightouch API for its type. Keys the API adds that are not set here are ignored.",
+			CustomType:  jsontype.NormalizedType{},
+			Required:    true,
+		},
+		"credentials": schema.MapAttribute{
+			Description: "Secret configuration values, such as API keys, tokens or passwords, merged into the configuration sent to the API. Write-only: it is never stored in state, so change credentials_version to send new values. Requires Terraform 1.11 or later.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"credentials_version": schema.Int64Attribute{
+			Description: "Change this value to send the current credentials to Hightouch, for example after rotating an API key.",
+			Optional:    true,
+		},
+		"workspace_id": schema.Int64Attribute{
+			Description: "The ID of the workspace that the destination belongs to.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The timestamp when the destination was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The timestamp when the destination was last updated.",
+			Computed:    true,
+		},
+	},
+}
+
+var DestinationsDataSourceSchema = datasourceschema.Schema{
+	Description: "Lists Hightouch Destinations of any type, optionally filtered.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"name_regex": datasourceschema.StringAttribute{
+			Description: "A regular expression that destination names must match.",
+			Optional:    true,
+		},
+		"slug_prefix": datasourceschema.StringAttribute{
+			Description: "A prefix that destination slugs must start with.",
+			Optional:    true,
+		},
+		"type": datasourceschema.StringAttribute{
+			Description: "Only return destinations of this type (e.g., 'iterable').",
+			Optional:    true,
+		},
+		"destinations": datasourceschema.ListNestedAttribute{
+			Description: "The matching destinations, ordered by ID.",
+			Computed:    true,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: map[string]datasourceschema.Attribute{
+					"id": datasourceschema.Int64Attribute{
+						Description: "The ID of the destination.",
+						Computed:    true,
+					},
+					"name": datasourceschema.StringAttribute{
+						Description: "The name of the destination.",
+						Computed:    true,
+					},
+					"slug": datasourceschema.StringAttribute{
+						Description: "The slug of the destination.",
+						Computed:    true,
+					},
+					"type": datasourceschema.StringAttribute{
+						Description: "The type of the destination.",
+						Computed:    true,
+					},
+					"workspace_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the workspace that the destination belongs to.",
+						Computed:    true,
+					},
+					"created_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the destination was created.",
+						Computed:    true,
+					},
+					"updated_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the destination was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
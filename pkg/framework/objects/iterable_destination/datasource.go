@@ -2,8 +2,11 @@ package iterable_destination
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
@@ -58,27 +61,49 @@ func (d *IterableDestinationDataSource) Configure(
 	d.client = client
 }
 
+// ConfigValidators requires exactly one of id, slug or name to be configured.
+func (d *IterableDestinationDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *IterableDestinationDataSource) Read(
 	ctx context.Context,
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var config IterableDestinationResourceModel
+	var config IterableDestinationDataSourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Get destination from Hightouch API
-	destinationID := int(config.ID.ValueInt64())
-	if destinationID == 0 {
-		resp.Diagnostics.AddError("Invalid Destination ID", "The destination ID must be provided.")
-		return
+	// Look the destination up by whichever of id, slug or name is configured
+	var destination *hightouch.HightouchDestination
+	var err error
+	if !config.ID.IsNull() {
+		destination, err = d.client.GetHightouchDestination(ctx, int(config.ID.ValueInt64()))
+	} else {
+		destination, err = d.client.FindHightouchDestination(ctx, hightouch.ListOptions{
+			Name: config.Name.ValueString(),
+			Slug: config.Slug.ValueString(),
+		})
 	}
 
-	destination, err := d.client.GetHightouchDestination(destinationID)
+	var lookupErr *hightouch.LookupError
+	if errors.As(err, &lookupErr) {
+		resp.Diagnostics.AddAttributeError(path.Root(lookupErr.Field), "Destination Lookup Failed", lookupErr.Error())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading destination", "Could not read destination, unexpected error: "+err.Error())
 		return
@@ -94,9 +119,6 @@ func (d *IterableDestinationDataSource) Read(
 	config.UpdatedAt = types.StringValue(destination.UpdatedAt.String())
 
 	// Extract configuration fields
-	if apiKeyString, ok := destination.Configuration["api_key"].(string); ok {
-		config.APIKey = types.StringValue(apiKeyString)
-	}
 	if dataCenterString, ok := destination.Configuration["data_center"].(string); ok {
 		config.DataCenter = types.StringValue(dataCenterString)
 	} else {
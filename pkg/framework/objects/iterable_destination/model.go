@@ -6,11 +6,25 @@ import (
 
 // IterableDestinationResourceModel maps the resource schema data for an Iterable destination in Hightouch.
 type IterableDestinationResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Slug            types.String `tfsdk:"slug"`
+	Type            types.String `tfsdk:"type"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWOVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	DataCenter      types.String `tfsdk:"data_center"`
+	WorkspaceID     types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// IterableDestinationDataSourceModel maps the data source schema data for an
+// Iterable destination. It has no API key, which is never read back.
+type IterableDestinationDataSourceModel struct {
 	ID          types.Int64  `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Slug        types.String `tfsdk:"slug"`
 	Type        types.String `tfsdk:"type"`
-	APIKey      types.String `tfsdk:"api_key"`
 	DataCenter  types.String `tfsdk:"data_center"`
 	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
 	CreatedAt   types.String `tfsdk:"created_at"`
@@ -2,11 +2,14 @@ package iterable_destination
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strconv"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/credentials"
+	"terraform-provider-hightouch/pkg/framework/identity"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
 
@@ -38,6 +41,24 @@ func (r *IterableDestinationResource) Schema(
 	resp.Schema = IterableDestinationResourceSchema
 }
 
+// UpgradeState upgrades the state of destinations written by earlier versions
+// of the schema.
+func (r *IterableDestinationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the API key, which is now write-only.
+		0: credentials.ClearFromState("api_key", "api_key_wo_version"),
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *IterableDestinationResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
 // Configure adds the hightouch configured client to the resource.
 func (r *IterableDestinationResource) Configure(
 	_ context.Context,
@@ -74,11 +95,19 @@ func (r *IterableDestinationResource) Create(
 
 	// Convert configuration from Terraform types to Go types
 	config := make(map[string]interface{})
-	config["api_key"] = plan.APIKey.ValueString()
 	config["data_center"] = plan.DataCenter.ValueString()
 
+	// The API key is write-only, so it is only in the configuration
+	var apiKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config["api_key"] = apiKey.ValueString()
+
 	// Call the API to create the destination
 	destination, err := r.client.CreateHightouchDestination(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Slug.ValueString(),
 		plan.Type.ValueString(),
@@ -99,6 +128,7 @@ func (r *IterableDestinationResource) Create(
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, destination.WorkspaceID, destination.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -123,7 +153,16 @@ func (r *IterableDestinationResource) Read(
 		resp.Diagnostics.AddError("Invalid Destination ID", "The destination ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
 		return
 	}
-	destination, err := r.client.GetHightouchDestination(destinationID)
+	destination, err := r.client.GetHightouchDestination(ctx, destinationID)
+	if hightouch.IsNotFound(err) {
+		// The destination was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, "Iterable destination no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"destination_id": destinationID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading destination", "Could not read destination, unexpected error: "+err.Error())
 		return
@@ -145,22 +184,17 @@ func (r *IterableDestinationResource) Read(
 	state.CreatedAt = types.StringValue(destination.CreatedAt.String())
 
 	// Convert configuration from Go types to Terraform types
-	apiKeyString, ok := destination.Configuration["api_key"].(string)
-	if !ok {
-		fmt.Println("api_key is not a string")
-		return
-	}
 	dataCenterString, ok := destination.Configuration["data_center"].(string)
 	if !ok {
 		// Default to US if not specified
 		dataCenterString = "US"
 	}
 
-	state.APIKey = types.StringValue(apiKeyString)
 	state.DataCenter = types.StringValue(dataCenterString)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, destination.WorkspaceID, destination.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -182,22 +216,33 @@ func (r *IterableDestinationResource) Update(
 		resp.Diagnostics.AddError("Invalid Destination ID", "The destination ID must be set before updating. Please ensure the resource has been created successfully before attempting to update it.")
 		return
 	}
-	fmt.Printf("DestinationID: %+v\n", destinationID)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	fmt.Printf("Destination Payload: %+v\n", plan)
-	fmt.Printf("Destination State: %+v\n", state)
+	tflog.Debug(ctx, "Updating Iterable destination", map[string]interface{}{
+		"destination_id": destinationID,
+	})
 
 	// Convert configuration from Terraform types to Go types
 	config := make(map[string]interface{})
-	config["api_key"] = plan.APIKey.ValueString()
 	config["data_center"] = plan.DataCenter.ValueString()
 
+	// The API keeps the stored key when it is omitted, so it is only sent when
+	// api_key_wo_version changes
+	if !plan.APIKeyWOVersion.Equal(state.APIKeyWOVersion) {
+		var apiKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &apiKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config["api_key"] = apiKey.ValueString()
+	}
+
 	// Call the API to update the destination
 	destination, err := r.client.UpdateHightouchDestination(
+		ctx,
 		destinationID,
 		plan.Name.ValueString(),
 		config,
@@ -213,6 +258,7 @@ func (r *IterableDestinationResource) Update(
 	plan.ID = types.Int64Value(int64(destinationID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, destination.WorkspaceID, destination.Slug)...)
 }
 
 // Delete deletes the resource from the remote API.
@@ -228,29 +274,57 @@ func (r *IterableDestinationResource) Delete(
 		return
 	}
 
-	// To complete this, a `DeleteDestination` method would be needed on the client.
-	// For example:
-	// err := r.client.DeleteDestination(int(state.ID.ValueInt64()))
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Error deleting destination", "Could not delete destination, unexpected error: "+err.Error())
-	//     return
-	// }
-
-	// For now, we'll simulate a successful deletion.
-	// In a real implementation, you would call your client's delete method here.
-	resp.Diagnostics.AddWarning("Delete not implemented", "The Hightouch API does not currently support deleting destinations via the API. The resource will be removed from Terraform state, but not from Hightouch.")
+	destinationID := int(state.ID.ValueInt64())
+	if destinationID == 0 {
+		resp.Diagnostics.AddError("Invalid Destination ID", "The destination ID must be set before deleting.")
+		return
+	}
+
+	// A destination that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchDestination(ctx, destinationID)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			"Destination Has Dependent Syncs",
+			fmt.Sprintf("Destination %d cannot be deleted while syncs still reference it. Delete those syncs first. If they are managed by Terraform, make sure they reference this destination through its id attribute (e.g. destination_id = <this resource>.id) so Terraform destroys them before it.\n\n%s", destinationID, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting destination", "Could not delete destination, unexpected error: "+err.Error())
+		return
+	}
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports the resource into Terraform state. The destination can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
 func (r *IterableDestinationResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.Atoi(req.ID)
+	key, diags := identity.ImportKey(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var destination *hightouch.HightouchDestination
+	var err error
+	if key.ID != 0 {
+		destination, err = r.client.GetHightouchDestination(ctx, key.ID)
+	} else {
+		destination, err = r.client.FindHightouchDestination(ctx, hightouch.ListOptions{Slug: key.Slug})
+	}
+	if err == nil {
+		err = key.CheckWorkspace(destination.WorkspaceID)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID for Import", "ID must be a valid integer.")
+		resp.Diagnostics.AddError("Error importing destination", "Could not find the destination to import: "+err.Error())
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *destination.ID)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, destination.WorkspaceID, destination.Slug)...)
 }
@@ -0,0 +1,65 @@
+package iterable_destination_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccIterableDestinationResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_iterable_destination", server.HasDestination),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccIterableDestinationConfig("Iterable", "US", "iterable-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_iterable_destination.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_iterable_destination.test", "name", "Iterable"),
+					resource.TestCheckResourceAttr("hightouch_iterable_destination.test", "type", "iterable"),
+					resource.TestCheckResourceAttr("hightouch_iterable_destination.test", "data_center", "US"),
+					resource.TestCheckNoResourceAttr("hightouch_iterable_destination.test", "api_key"),
+					acctest.CheckDestinationConfiguration(server, "hightouch_iterable_destination.test", "api_key", "iterable-secret"),
+				),
+			},
+			{
+				ResourceName:            "hightouch_iterable_destination.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key_wo_version"},
+			},
+			{
+				// A new key is not sent until api_key_wo_version changes.
+				Config: acctest.ProviderConfig(server) + testAccIterableDestinationConfig("Iterable EU", "EU", "rotated-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_iterable_destination.test", "name", "Iterable EU"),
+					resource.TestCheckResourceAttr("hightouch_iterable_destination.test", "data_center", "EU"),
+					acctest.CheckDestinationConfiguration(server, "hightouch_iterable_destination.test", "api_key", "iterable-secret"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccIterableDestinationConfig("Iterable EU", "EU", "rotated-secret", 2),
+				Check:  acctest.CheckDestinationConfiguration(server, "hightouch_iterable_destination.test", "api_key", "rotated-secret"),
+			},
+		},
+	})
+}
+
+func testAccIterableDestinationConfig(name, dataCenter, apiKey string, apiKeyVersion int) string {
+	return fmt.Sprintf(`
+resource "hightouch_iterable_destination" "test" {
+  name               = %q
+  slug               = "acc-iterable"
+  api_key            = %q
+  api_key_wo_version = %d
+  data_center        = %q
+}
+`, name, apiKey, apiKeyVersion, dataCenter)
+}
@@ -9,6 +9,8 @@ import (
 )
 
 var IterableDestinationResourceSchema = schema.Schema{
+	// Version 1 made api_key write-only.
+	Version:     1,
 	Description: "Represents a Hightouch Iterable Destination, which is a connector to send data to Iterable for marketing campaigns.",
 	Attributes: map[string]schema.Attribute{
 		"id": schema.Int64Attribute{
@@ -20,8 +22,11 @@ var IterableDestinationResourceSchema = schema.Schema{
 			Required:    true,
 		},
 		"slug": schema.StringAttribute{
-			Description: "The slug of the destination.",
+			Description: "The slug of the destination. Slugs cannot be changed in place, so changing it replaces the destination.",
 			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 		"type": schema.StringAttribute{
 			Description: "The type of the destination, 'iterable'.",
@@ -29,13 +34,19 @@ var IterableDestinationResourceSchema = schema.Schema{
 			Default:     stringdefault.StaticString("iterable"),
 		},
 		"api_key": schema.StringAttribute{
-			Description: "The Iterable API key for authentication.",
+			Description: "The Iterable API key for authentication. Write-only: it is never stored in state, so change api_key_wo_version to send a new key. Requires Terraform 1.11 or later.",
 			Required:    true,
 			Sensitive:   true, // Mark as sensitive to avoid logging
+			WriteOnly:   true,
+		},
+		"api_key_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current API key to Hightouch, for example after rotating it.",
+			Optional:    true,
 		},
 		"data_center": schema.StringAttribute{
 			Description: "The Iterable data center (US or EU).",
 			Optional:    true,
+			Computed:    true,
 			Default:     stringdefault.StaticString("US"),
 		},
 		"workspace_id": schema.Int64Attribute{
@@ -60,26 +71,24 @@ var IterableDestinationDataSourceSchema = datasourceschema.Schema{
 	Description: "Fetches information about a Hightouch Iterable Destination.",
 	Attributes: map[string]datasourceschema.Attribute{
 		"id": datasourceschema.Int64Attribute{
-			Description: "The ID of the destination.",
-			Required:    true,
+			Description: "The ID of the destination. Exactly one of `id`, `slug` or `name` must be set to look the destination up.",
+			Optional:    true,
+			Computed:    true,
 		},
 		"name": datasourceschema.StringAttribute{
-			Description: "The name of the destination.",
+			Description: "The name of the destination. Exactly one of `id`, `slug` or `name` must be set to look the destination up; the lookup fails if several destinations share the name.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"slug": datasourceschema.StringAttribute{
-			Description: "The slug of the destination.",
+			Description: "The slug of the destination. Exactly one of `id`, `slug` or `name` must be set to look the destination up.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"type": datasourceschema.StringAttribute{
 			Description: "The type of the destination.",
 			Computed:    true,
 		},
-		"api_key": datasourceschema.StringAttribute{
-			Description: "The Iterable API key for authentication.",
-			Computed:    true,
-			Sensitive:   true,
-		},
 		"data_center": datasourceschema.StringAttribute{
 			Description: "The Iterable data center (US or EU).",
 			Computed:    true,
@@ -2,8 +2,11 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
@@ -58,6 +61,19 @@ func (d *ModelDataSource) Configure(
 	d.client = client
 }
 
+// ConfigValidators requires exactly one of id, slug or name to be configured.
+func (d *ModelDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *ModelDataSource) Read(
 	ctx context.Context,
@@ -71,14 +87,23 @@ func (d *ModelDataSource) Read(
 		return
 	}
 
-	// Get model from Hightouch API
-	modelID := int(config.ID.ValueInt64())
-	if modelID == 0 {
-		resp.Diagnostics.AddError("Invalid Model ID", "The model ID must be provided.")
-		return
+	// Look the model up by whichever of id, slug or name is configured
+	var model *hightouch.HightouchModel
+	var err error
+	if !config.ID.IsNull() {
+		model, err = d.client.GetHightouchModel(ctx, int(config.ID.ValueInt64()))
+	} else {
+		model, err = d.client.FindHightouchModel(ctx, hightouch.ListOptions{
+			Name: config.Name.ValueString(),
+			Slug: config.Slug.ValueString(),
+		})
 	}
 
-	model, err := d.client.GetHightouchModel(modelID)
+	var lookupErr *hightouch.LookupError
+	if errors.As(err, &lookupErr) {
+		resp.Diagnostics.AddAttributeError(path.Root(lookupErr.Field), "Model Lookup Failed", lookupErr.Error())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading model", "Could not read model, unexpected error: "+err.Error())
 		return
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// ModelsDataSource is the data source implementation for listing models.
+type ModelsDataSource struct {
+	client *hightouch.Client
+}
+
+// NewModelsDataSource is a helper function to simplify data source server allocation.
+func NewModelsDataSource() datasource.DataSource {
+	return &ModelsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ModelsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+// Schema defines the schema for the data source.
+func (d *ModelsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ModelsDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ModelsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ModelsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config ModelsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := helper.NewNameFilter(config.NameRegex.ValueString(), config.SlugPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		return
+	}
+
+	models, err := d.client.ListHightouchModels(ctx, hightouch.ListOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing models", "Could not list models, unexpected error: "+err.Error())
+		return
+	}
+
+	config.Models = []ModelSummaryModel{}
+	for _, model := range models {
+		if !filter.Match(model.Name, model.Slug) {
+			continue
+		}
+		if !config.SourceID.IsNull() && int64(model.SourceID) != config.SourceID.ValueInt64() {
+			continue
+		}
+
+		config.Models = append(config.Models, ModelSummaryModel{
+			ID:          types.Int64Value(int64(*model.ID)),
+			Name:        types.StringValue(model.Name),
+			Slug:        types.StringValue(model.Slug),
+			SourceID:    types.Int64Value(int64(model.SourceID)),
+			QueryType:   types.StringValue(model.QueryType),
+			PrimaryKey:  types.StringValue(model.PrimaryKey),
+			WorkspaceID: types.Int64Value(int64(model.WorkspaceID)),
+			CreatedAt:   types.StringValue(model.CreatedAt.String()),
+			UpdatedAt:   types.StringValue(model.UpdatedAt.String()),
+		})
+	}
+
+	// The API does not promise an order, so sort by ID for a stable list
+	slices.SortFunc(config.Models, func(a, b ModelSummaryModel) int {
+		return cmp.Compare(a.ID.ValueInt64(), b.ID.ValueInt64())
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
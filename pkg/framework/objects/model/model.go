@@ -19,3 +19,24 @@ type ModelResourceModel struct {
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
 }
+
+// ModelsDataSourceModel maps the schema data for the hightouch_models data source.
+type ModelsDataSourceModel struct {
+	NameRegex  types.String        `tfsdk:"name_regex"`
+	SlugPrefix types.String        `tfsdk:"slug_prefix"`
+	SourceID   types.Int64         `tfsdk:"source_id"`
+	Models     []ModelSummaryModel `tfsdk:"models"`
+}
+
+// ModelSummaryModel maps a single model returned by the hightouch_models data source.
+type ModelSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	SourceID    types.Int64  `tfsdk:"source_id"`
+	QueryType   types.String `tfsdk:"query_type"`
+	PrimaryKey  types.String `tfsdk:"primary_key"`
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
@@ -2,11 +2,13 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strconv"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/identity"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
 
@@ -38,6 +40,15 @@ func (r *ModelResource) Schema(
 	resp.Schema = ModelResourceSchema
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *ModelResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
 // Configure adds the hightouch configured client to the resource.
 func (r *ModelResource) Configure(
 	_ context.Context,
@@ -74,6 +85,7 @@ func (r *ModelResource) Create(
 
 	// Call the API to create the model
 	model, err := r.client.CreateHightouchModel(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Slug.ValueString(),
 		int(plan.SourceID.ValueInt64()),
@@ -98,6 +110,7 @@ func (r *ModelResource) Create(
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, model.WorkspaceID, model.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -122,7 +135,16 @@ func (r *ModelResource) Read(
 		resp.Diagnostics.AddError("Invalid Model ID", "The model ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
 		return
 	}
-	model, err := r.client.GetHightouchModel(modelID)
+	model, err := r.client.GetHightouchModel(ctx, modelID)
+	if hightouch.IsNotFound(err) {
+		// The model was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, "Model no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"model_id": modelID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading model", "Could not read model, unexpected error: "+err.Error())
 		return
@@ -150,6 +172,7 @@ func (r *ModelResource) Read(
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, model.WorkspaceID, model.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -178,6 +201,7 @@ func (r *ModelResource) Update(
 
 	// Call the API to update the model
 	model, err := r.client.UpdateHightouchModel(
+		ctx,
 		modelID,
 		plan.Name.ValueString(),
 		plan.SQL.ValueString(),
@@ -194,6 +218,7 @@ func (r *ModelResource) Update(
 	plan.ID = types.Int64Value(int64(modelID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, model.WorkspaceID, model.Slug)...)
 }
 
 // Delete deletes the resource from the remote API.
@@ -209,20 +234,57 @@ func (r *ModelResource) Delete(
 		return
 	}
 
-	// For now, we'll simulate a successful deletion since delete is not implemented in the SDK
-	resp.Diagnostics.AddWarning("Delete not implemented", "The Hightouch API does not currently support deleting models via the API. The resource will be removed from Terraform state, but not from Hightouch.")
+	modelID := int(state.ID.ValueInt64())
+	if modelID == 0 {
+		resp.Diagnostics.AddError("Invalid Model ID", "The model ID must be set before deleting.")
+		return
+	}
+
+	// A model that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchModel(ctx, modelID)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			"Model Has Dependent Syncs",
+			fmt.Sprintf("Model %d cannot be deleted while syncs still reference it. Delete those syncs first. If they are managed by Terraform, make sure they reference this model through its id attribute (e.g. model_id = <this resource>.id) so Terraform destroys them before it.\n\n%s", modelID, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting model", "Could not delete model, unexpected error: "+err.Error())
+		return
+	}
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports the resource into Terraform state. The model can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
 func (r *ModelResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.Atoi(req.ID)
+	key, diags := identity.ImportKey(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var model *hightouch.HightouchModel
+	var err error
+	if key.ID != 0 {
+		model, err = r.client.GetHightouchModel(ctx, key.ID)
+	} else {
+		model, err = r.client.FindHightouchModel(ctx, hightouch.ListOptions{Slug: key.Slug})
+	}
+	if err == nil {
+		err = key.CheckWorkspace(model.WorkspaceID)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID for Import", "ID must be a valid integer.")
+		resp.Diagnostics.AddError("Error importing model", "Could not find the model to import: "+err.Error())
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *model.ID)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, model.WorkspaceID, model.Slug)...)
 }
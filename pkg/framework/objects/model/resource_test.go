@@ -0,0 +1,162 @@
+package model_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccModelResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_model", server.HasModel),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Users", "select id, email from users"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_model.test", "id"),
+					resource.TestCheckResourceAttrPair("hightouch_model.test", "source_id", "hightouch_snowflake_source.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_model.test", "query_type", "sql"),
+					resource.TestCheckResourceAttr("hightouch_model.test", "primary_key", "id"),
+				),
+			},
+			{
+				ResourceName:      "hightouch_model.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "hightouch_model.test",
+				ImportState:       true,
+				ImportStateId:     "slug:acc-users",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "hightouch_model.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%d/acc-users", hightouchtest.WorkspaceID),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "hightouch_model.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%d/acc-users", hightouchtest.WorkspaceID+1),
+				ExpectError:   regexp.MustCompile(`belongs to workspace 1, not workspace 2`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Active Users", "select id, email from users where active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_model.test", "name", "Active Users"),
+					resource.TestCheckResourceAttr("hightouch_model.test", "sql", "select id, email from users where active"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccModelResource_importByIdentity imports a model with an import block
+// that identifies it by slug, as shared modules do across workspaces.
+func TestAccModelResource_importByIdentity(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Users", "select id, email from users"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("hightouch_model.test", map[string]knownvalue.Check{
+						"workspace_id": knownvalue.Int64Exact(hightouchtest.WorkspaceID),
+						"slug":         knownvalue.StringExact("acc-users"),
+					}),
+				},
+			},
+			{
+				ResourceName:    "hightouch_model.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccModelDataSource_lookup(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Users", "select * from users") + `
+data "hightouch_model" "by_slug" {
+  slug = hightouch_model.test.slug
+}
+
+data "hightouch_model" "by_name" {
+  name = hightouch_model.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.hightouch_model.by_slug", "id", "hightouch_model.test", "id"),
+					resource.TestCheckResourceAttrPair("data.hightouch_model.by_name", "id", "hightouch_model.test", "id"),
+					resource.TestCheckResourceAttr("data.hightouch_model.by_name", "slug", "acc-users"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Users", "select * from users") + `
+data "hightouch_model" "invalid" {
+  id   = hightouch_model.test.id
+  slug = hightouch_model.test.slug
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccModelConfig("Users", "select * from users") + `
+data "hightouch_model" "missing" {
+  slug = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`no model with slug "does-not-exist" was found`),
+			},
+		},
+	})
+}
+
+func testAccModelConfig(name, sql string) string {
+	return fmt.Sprintf(`
+resource "hightouch_snowflake_source" "test" {
+  name      = "Warehouse"
+  slug      = "acc-model-warehouse"
+  account   = "acme"
+  port      = 443
+  username  = "loader"
+  password  = "hunter2"
+  database  = "ANALYTICS"
+  warehouse = "COMPUTE_WH"
+}
+
+resource "hightouch_model" "test" {
+  name        = %q
+  slug        = "acc-users"
+  source_id   = hightouch_snowflake_source.test.id
+  sql         = %q
+  primary_key = "id"
+}
+`, name, sql)
+}
@@ -3,6 +3,7 @@ package model
 import (
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,8 +21,11 @@ var ModelResourceSchema = schema.Schema{
 			Required:    true,
 		},
 		"slug": schema.StringAttribute{
-			Description: "The slug of the model.",
+			Description: "The slug of the model. Slugs cannot be changed in place, so changing it replaces the model.",
 			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 		"source_id": schema.Int64Attribute{
 			Description: "The ID of the source this model queries from.",
@@ -34,10 +38,15 @@ var ModelResourceSchema = schema.Schema{
 		"dbt_table": schema.StringAttribute{
 			Description: "The dbt table name if using dbt.",
 			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
 		},
 		"query_type": schema.StringAttribute{
 			Description: "The type of query (e.g., 'sql', 'dbt').",
 			Optional:    true,
+			Computed:    true,
 			Default:     stringdefault.StaticString("sql"),
 		},
 		"primary_key": schema.StringAttribute{
@@ -47,6 +56,10 @@ var ModelResourceSchema = schema.Schema{
 		"is_schema": schema.BoolAttribute{
 			Description: "Whether this model represents a schema.",
 			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
 		},
 		"workspace_id": schema.Int64Attribute{
 			Description: "The ID of the workspace that the model belongs to.",
@@ -70,15 +83,18 @@ var ModelDataSourceSchema = datasourceschema.Schema{
 	Description: "Fetches information about a Hightouch Model.",
 	Attributes: map[string]datasourceschema.Attribute{
 		"id": datasourceschema.Int64Attribute{
-			Description: "The ID of the model.",
-			Required:    true,
+			Description: "The ID of the model. Exactly one of `id`, `slug` or `name` must be set to look the model up.",
+			Optional:    true,
+			Computed:    true,
 		},
 		"name": datasourceschema.StringAttribute{
-			Description: "The name of the model.",
+			Description: "The name of the model. Exactly one of `id`, `slug` or `name` must be set to look the model up; the lookup fails if several models share the name.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"slug": datasourceschema.StringAttribute{
-			Description: "The slug of the model.",
+			Description: "The slug of the model. Exactly one of `id`, `slug` or `name` must be set to look the model up.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"source_id": datasourceschema.Int64Attribute{
@@ -119,3 +135,65 @@ var ModelDataSourceSchema = datasourceschema.Schema{
 		},
 	},
 }
+
+var ModelsDataSourceSchema = datasourceschema.Schema{
+	Description: "Lists Hightouch Models, optionally filtered.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"name_regex": datasourceschema.StringAttribute{
+			Description: "A regular expression that model names must match.",
+			Optional:    true,
+		},
+		"slug_prefix": datasourceschema.StringAttribute{
+			Description: "A prefix that model slugs must start with.",
+			Optional:    true,
+		},
+		"source_id": datasourceschema.Int64Attribute{
+			Description: "Only return models that query this source.",
+			Optional:    true,
+		},
+		"models": datasourceschema.ListNestedAttribute{
+			Description: "The matching models, ordered by ID.",
+			Computed:    true,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: map[string]datasourceschema.Attribute{
+					"id": datasourceschema.Int64Attribute{
+						Description: "The ID of the model.",
+						Computed:    true,
+					},
+					"name": datasourceschema.StringAttribute{
+						Description: "The name of the model.",
+						Computed:    true,
+					},
+					"slug": datasourceschema.StringAttribute{
+						Description: "The slug of the model.",
+						Computed:    true,
+					},
+					"source_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the source this model queries from.",
+						Computed:    true,
+					},
+					"query_type": datasourceschema.StringAttribute{
+						Description: "The type of query (e.g., 'sql', 'dbt').",
+						Computed:    true,
+					},
+					"primary_key": datasourceschema.StringAttribute{
+						Description: "The primary key column for the model.",
+						Computed:    true,
+					},
+					"workspace_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the workspace that the model belongs to.",
+						Computed:    true,
+					},
+					"created_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the model was created.",
+						Computed:    true,
+					},
+					"updated_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the model was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
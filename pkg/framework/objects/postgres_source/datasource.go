@@ -0,0 +1,36 @@
+package postgres_source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-hightouch/pkg/framework/databasesource"
+)
+
+// PostgresSourceDataSource is the data source implementation. Everything but its
+// name and schema is shared with the other database sources.
+type PostgresSourceDataSource struct {
+	databasesource.DataSource
+}
+
+// NewPostgresSourceDataSource is a helper function to simplify data source server allocation.
+func NewPostgresSourceDataSource() datasource.DataSource {
+	return &PostgresSourceDataSource{databasesource.DataSource{Kind: kind}}
+}
+
+// Metadata returns the data source type name.
+func (d *PostgresSourceDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_source"
+}
+
+// Schema defines the schema for the data source.
+func (d *PostgresSourceDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = PostgresSourceDataSourceSchema
+}
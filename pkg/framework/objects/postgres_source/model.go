@@ -0,0 +1,10 @@
+package postgres_source
+
+import "terraform-provider-hightouch/pkg/framework/databasesource"
+
+// PostgresSourceResourceModel maps the resource schema data for a Postgres source in Hightouch.
+type PostgresSourceResourceModel = databasesource.Model
+
+// PostgresSourceDataSourceModel maps the data source schema data for a Postgres
+// source.
+type PostgresSourceDataSourceModel = databasesource.DataSourceModel
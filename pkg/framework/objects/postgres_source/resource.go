@@ -0,0 +1,36 @@
+package postgres_source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-hightouch/pkg/framework/databasesource"
+)
+
+// PostgresSourceResource is the resource implementation. Everything but its name
+// and schema is shared with the other database sources.
+type PostgresSourceResource struct {
+	databasesource.Resource
+}
+
+// NewPostgresSourceResource is a helper function to simplify resource server allocation.
+func NewPostgresSourceResource() resource.Resource {
+	return &PostgresSourceResource{databasesource.Resource{Kind: kind}}
+}
+
+// Metadata returns the resource type name.
+func (r *PostgresSourceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_source"
+}
+
+// Schema defines the schema for the resource.
+func (r *PostgresSourceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = PostgresSourceResourceSchema
+}
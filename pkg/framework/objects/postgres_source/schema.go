@@ -0,0 +1,16 @@
+package postgres_source
+
+import "terraform-provider-hightouch/pkg/framework/databasesource"
+
+// kind describes the sources managed by this package.
+var kind = databasesource.Kind{
+	Type:            "postgres",
+	Title:           "Postgres",
+	DefaultPort:     5432,
+	HostDescription: "Hostname or IP address of the database server. With ssh_tunnel, the address as seen from the bastion host.",
+	PortDescription: "Port of the database server. Defaults to 5432.",
+}
+
+var PostgresSourceResourceSchema = databasesource.ResourceSchema(kind)
+
+var PostgresSourceDataSourceSchema = databasesource.DataSourceSchema(kind)
@@ -0,0 +1,36 @@
+package redshift_source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-hightouch/pkg/framework/databasesource"
+)
+
+// RedshiftSourceDataSource is the data source implementation. Everything but its
+// name and schema is shared with the other database sources.
+type RedshiftSourceDataSource struct {
+	databasesource.DataSource
+}
+
+// NewRedshiftSourceDataSource is a helper function to simplify data source server allocation.
+func NewRedshiftSourceDataSource() datasource.DataSource {
+	return &RedshiftSourceDataSource{databasesource.DataSource{Kind: kind}}
+}
+
+// Metadata returns the data source type name.
+func (d *RedshiftSourceDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_redshift_source"
+}
+
+// Schema defines the schema for the data source.
+func (d *RedshiftSourceDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = RedshiftSourceDataSourceSchema
+}
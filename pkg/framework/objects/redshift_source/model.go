@@ -0,0 +1,10 @@
+package redshift_source
+
+import "terraform-provider-hightouch/pkg/framework/databasesource"
+
+// RedshiftSourceResourceModel maps the resource schema data for a Redshift source in Hightouch.
+type RedshiftSourceResourceModel = databasesource.Model
+
+// RedshiftSourceDataSourceModel maps the data source schema data for a Redshift
+// source.
+type RedshiftSourceDataSourceModel = databasesource.DataSourceModel
@@ -0,0 +1,36 @@
+package redshift_source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-hightouch/pkg/framework/databasesource"
+)
+
+// RedshiftSourceResource is the resource implementation. Everything but its name
+// and schema is shared with the other database sources.
+type RedshiftSourceResource struct {
+	databasesource.Resource
+}
+
+// NewRedshiftSourceResource is a helper function to simplify resource server allocation.
+func NewRedshiftSourceResource() resource.Resource {
+	return &RedshiftSourceResource{databasesource.Resource{Kind: kind}}
+}
+
+// Metadata returns the resource type name.
+func (r *RedshiftSourceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_redshift_source"
+}
+
+// Schema defines the schema for the resource.
+func (r *RedshiftSourceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = RedshiftSourceResourceSchema
+}
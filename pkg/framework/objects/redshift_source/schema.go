@@ -0,0 +1,16 @@
+package redshift_source
+
+import "terraform-provider-hightouch/pkg/framework/databasesource"
+
+// kind describes the sources managed by this package.
+var kind = databasesource.Kind{
+	Type:            "redshift",
+	Title:           "Redshift",
+	DefaultPort:     5439,
+	HostDescription: "Endpoint of the Redshift cluster or serverless workgroup. With ssh_tunnel, the address as seen from the bastion host.",
+	PortDescription: "Port of the cluster. Defaults to 5439.",
+}
+
+var RedshiftSourceResourceSchema = databasesource.ResourceSchema(kind)
+
+var RedshiftSourceDataSourceSchema = databasesource.DataSourceSchema(kind)
@@ -0,0 +1,127 @@
+package snowflake_source
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/authmethod"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// Values of the auth_method attribute, which are also the values of the
+// auth_method configuration key in the Hightouch API.
+const (
+	authMethodPassword = "password"
+	authMethodKeyPair  = "key_pair"
+	authMethodOAuth    = "oauth"
+)
+
+// authMethods lists the attributes of each auth method. The passphrase of a
+// private key is optional.
+var authMethods = authmethod.Methods{
+	Default: authMethodPassword,
+	ByName: map[string]authmethod.Method{
+		authMethodPassword: {
+			Required: []string{"password"},
+			Secrets:  []string{"password"},
+			Version:  "password_wo_version",
+		},
+		authMethodKeyPair: {
+			Required: []string{"private_key"},
+			Secrets:  []string{"private_key", "private_key_passphrase"},
+			Version:  "private_key_wo_version",
+		},
+		authMethodOAuth: {
+			Required: []string{"oauth_client_id", "oauth_client_secret"},
+			Secrets:  []string{"oauth_client_secret"},
+			Version:  "oauth_client_secret_wo_version",
+		},
+	},
+}
+
+// ValidateConfig checks that exactly the attributes of the configured
+// auth_method are set, and that a known private key is PEM-encoded.
+func (r *SnowflakeSourceResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config SnowflakeSourceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(authMethods.Validate(config.AuthMethod, map[string]types.String{
+		"password":               config.Password,
+		"private_key":            config.PrivateKey,
+		"private_key_passphrase": config.PrivateKeyPassphrase,
+		"oauth_client_id":        config.OAuthClientID,
+		"oauth_client_secret":    config.OAuthClientSecret,
+	})...)
+
+	if key := config.PrivateKey; config.AuthMethod.ValueString() == authMethodKeyPair && !key.IsNull() && !key.IsUnknown() {
+		if block, _ := pem.Decode([]byte(key.ValueString())); block == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Invalid Private Key",
+				"private_key must be a PEM-encoded private key.",
+			)
+		}
+	}
+}
+
+// authConfiguration returns the configuration keys for the auth method in
+// plan. Write-only secrets are included only when sendSecrets is true; the
+// secrets of the other methods are then cleared.
+func authConfiguration(
+	ctx context.Context,
+	config tfsdk.Config,
+	plan SnowflakeSourceResourceModel,
+	sendSecrets bool,
+) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	method := plan.AuthMethod.ValueString()
+
+	configuration := map[string]interface{}{
+		"auth_method":     method,
+		"oauth_client_id": nil,
+	}
+	if method == authMethodOAuth {
+		configuration["oauth_client_id"] = plan.OAuthClientID.ValueString()
+	}
+	if !sendSecrets {
+		return configuration, diags
+	}
+
+	secrets, diags := authMethods.Secrets(ctx, config, method)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for key, value := range secrets {
+		configuration[key] = value
+	}
+	return configuration, diags
+}
+
+// optionalString returns the value of an optional attribute, or nil so that
+// unsetting the attribute clears the configuration key.
+func optionalString(value types.String) interface{} {
+	if value.IsNull() {
+		return nil
+	}
+	return value.ValueString()
+}
+
+// oauthClientID returns the OAuth client ID of a source, which is only
+// meaningful when it uses OAuth.
+func oauthClientID(configuration hightouch.SnowflakeConfiguration) types.String {
+	if authMethods.Name(configuration.AuthMethod) != authMethodOAuth {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(configuration.OAuthClientID)
+}
@@ -2,8 +2,11 @@ package snowflake_source
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
@@ -58,27 +61,54 @@ func (d *SnowflakeSourceDataSource) Configure(
 	d.client = client
 }
 
+// ConfigValidators requires exactly one of id, slug or name to be configured.
+func (d *SnowflakeSourceDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *SnowflakeSourceDataSource) Read(
 	ctx context.Context,
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var config SnowflakeSourceResourceModel
+	var config SnowflakeSourceDataSourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Get source from Hightouch API
-	sourceID := int(config.ID.ValueInt64())
-	if sourceID == 0 {
-		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be provided.")
-		return
+	// Look the source up by whichever of id, slug or name is configured
+	var source *hightouch.HightouchSource
+	var err error
+	if !config.ID.IsNull() {
+		source, err = d.client.GetHightouchSourceOfType(ctx, sourceType, int(config.ID.ValueInt64()))
+	} else {
+		source, err = d.client.FindHightouchSourceOfType(ctx, sourceType, hightouch.ListOptions{
+			Name: config.Name.ValueString(),
+			Slug: config.Slug.ValueString(),
+		})
 	}
 
-	source, err := d.client.GetSnowflakeSource(sourceID)
+	var lookupErr *hightouch.LookupError
+	if errors.As(err, &lookupErr) {
+		resp.Diagnostics.AddAttributeError(path.Root(lookupErr.Field), "Source Lookup Failed", lookupErr.Error())
+		return
+	}
+	var typeErr *hightouch.SourceTypeError
+	if errors.As(err, &typeErr) {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Source Lookup Failed", typeErr.Error())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading source", "Could not read source, unexpected error: "+err.Error())
 		return
@@ -93,25 +123,20 @@ func (d *SnowflakeSourceDataSource) Read(
 	config.CreatedAt = types.StringValue(source.CreatedAt.String())
 	config.UpdatedAt = types.StringValue(source.UpdatedAt.String())
 
-	// Extract configuration fields
-	if accountString, ok := source.Configuration["account"].(string); ok {
-		config.Account = types.StringValue(accountString)
-	}
-	if portFloat, ok := source.Configuration["port"].(float64); ok {
-		config.Port = types.Int64Value(int64(portFloat))
-	}
-	if usernameString, ok := source.Configuration["username"].(string); ok {
-		config.Username = types.StringValue(usernameString)
-	}
-	if passwordString, ok := source.Configuration["password"].(string); ok {
-		config.Password = types.StringValue(passwordString)
-	}
-	if databaseString, ok := source.Configuration["database"].(string); ok {
-		config.Database = types.StringValue(databaseString)
-	}
-	if warehouseString, ok := source.Configuration["warehouse"].(string); ok {
-		config.Warehouse = types.StringValue(warehouseString)
+	configuration, err := hightouch.ParseSnowflakeConfiguration(source.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", fmt.Sprintf("Could not decode the configuration of source %d: %s", *source.ID, err.Error()))
+		return
 	}
+	config.Account = types.StringValue(configuration.Account)
+	config.Port = types.Int64PointerValue(configuration.Port)
+	config.Username = types.StringValue(configuration.Username)
+	config.Database = types.StringValue(configuration.Database)
+	config.Warehouse = types.StringValue(configuration.Warehouse)
+	config.AuthMethod = types.StringValue(authMethods.Name(configuration.AuthMethod))
+	config.OAuthClientID = oauthClientID(configuration)
+	config.Role = types.StringPointerValue(configuration.Role)
+	config.Schema = types.StringPointerValue(configuration.Schema)
 
 	// Set state
 	diags = resp.State.Set(ctx, &config)
@@ -6,17 +6,48 @@ import (
 
 // SnowflakeSourceResourceModel maps the resource schema data for a Snowflake source in Hightouch.
 type SnowflakeSourceResourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Slug        types.String `tfsdk:"slug"`
-	Type        types.String `tfsdk:"type"`
-	Account     types.String `tfsdk:"account"`
-	Port        types.Int64  `tfsdk:"port"`
-	Username    types.String `tfsdk:"username"`
-	Database    types.String `tfsdk:"database"`
-	Password    types.String `tfsdk:"password"`
-	Warehouse   types.String `tfsdk:"warehouse"`
-	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                         types.Int64  `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	Slug                       types.String `tfsdk:"slug"`
+	Type                       types.String `tfsdk:"type"`
+	Account                    types.String `tfsdk:"account"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Username                   types.String `tfsdk:"username"`
+	Database                   types.String `tfsdk:"database"`
+	AuthMethod                 types.String `tfsdk:"auth_method"`
+	Password                   types.String `tfsdk:"password"`
+	PasswordWOVersion          types.Int64  `tfsdk:"password_wo_version"`
+	PrivateKey                 types.String `tfsdk:"private_key"`
+	PrivateKeyPassphrase       types.String `tfsdk:"private_key_passphrase"`
+	PrivateKeyWOVersion        types.Int64  `tfsdk:"private_key_wo_version"`
+	OAuthClientID              types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret          types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecretWOVersion types.Int64  `tfsdk:"oauth_client_secret_wo_version"`
+	Role                       types.String `tfsdk:"role"`
+	Schema                     types.String `tfsdk:"schema"`
+	Warehouse                  types.String `tfsdk:"warehouse"`
+	WorkspaceID                types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt                  types.String `tfsdk:"created_at"`
+	UpdatedAt                  types.String `tfsdk:"updated_at"`
+}
+
+// SnowflakeSourceDataSourceModel maps the data source schema data for a
+// Snowflake source. It has no password, which is never read back.
+type SnowflakeSourceDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Slug          types.String `tfsdk:"slug"`
+	Type          types.String `tfsdk:"type"`
+	Account       types.String `tfsdk:"account"`
+	Port          types.Int64  `tfsdk:"port"`
+	Username      types.String `tfsdk:"username"`
+	Database      types.String `tfsdk:"database"`
+	AuthMethod    types.String `tfsdk:"auth_method"`
+	OAuthClientID types.String `tfsdk:"oauth_client_id"`
+	Role          types.String `tfsdk:"role"`
+	Schema        types.String `tfsdk:"schema"`
+	Warehouse     types.String `tfsdk:"warehouse"`
+	WorkspaceID   types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
 }
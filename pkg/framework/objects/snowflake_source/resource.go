@@ -2,11 +2,13 @@ package snowflake_source
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strconv"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/credentials"
+	"terraform-provider-hightouch/pkg/framework/identity"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
 
@@ -38,6 +40,24 @@ func (r *SnowflakeSourceResource) Schema(
 	resp.Schema = SnowflakeSourceResourceSchema
 }
 
+// UpgradeState upgrades the state of sources written by earlier versions of
+// the schema.
+func (r *SnowflakeSourceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the password, which is now write-only.
+		0: credentials.ClearFromState("password", "password_wo_version"),
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *SnowflakeSourceResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
 // Configure adds the hightouch_resources configured client to the resource.
 func (r *SnowflakeSourceResource) Configure(
 	_ context.Context,
@@ -74,15 +94,26 @@ func (r *SnowflakeSourceResource) Create(
 
 	// Convert configuration from Terraform types to Go types
 	config := make(map[string]interface{})
-	config["port"] = plan.Port.ValueInt64()
+	config["port"] = plan.Port.ValueInt64Pointer()
 	config["account"] = plan.Account.ValueString()
 	config["username"] = plan.Username.ValueString()
 	config["database"] = plan.Database.ValueString()
 	config["warehouse"] = plan.Warehouse.ValueString()
-	config["password"] = plan.Password.ValueString()
+	config["role"] = optionalString(plan.Role)
+	config["schema"] = optionalString(plan.Schema)
+
+	auth, diags := authConfiguration(ctx, req.Config, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range auth {
+		config[key] = value
+	}
 
 	// Call the API to create the source
 	source, err := r.client.CreateHightouchSource(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Slug.ValueString(),
 		plan.Type.ValueString(),
@@ -103,6 +134,7 @@ func (r *SnowflakeSourceResource) Create(
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,7 +159,16 @@ func (r *SnowflakeSourceResource) Read(
 		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
 		return
 	}
-	source, err := r.client.GetSnowflakeSource(sourceID)
+	source, err := r.client.GetHightouchSource(ctx, sourceID)
+	if hightouch.IsNotFound(err) {
+		// The source was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, "Snowflake source no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"source_id": sourceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading source", "Could not read source, unexpected error: "+err.Error())
 		return
@@ -148,48 +189,26 @@ func (r *SnowflakeSourceResource) Read(
 	state.WorkspaceID = types.Int64Value(int64(source.WorkspaceID))
 	state.CreatedAt = types.StringValue(source.CreatedAt.String())
 
-	// Convert configuration from Go types to Terraform types
-	accountString, ok := source.Configuration["account"].(string)
-	if !ok {
-		fmt.Println("account is not a string")
-		return
-	}
-	portFloat, ok := source.Configuration["port"].(float64)
-	if !ok {
-		// Handle the error if the type is not a float64
-		fmt.Println("port is not a float64")
-		return
-	}
-	usernameString, ok := source.Configuration["username"].(string)
-	if !ok {
-		fmt.Println("username is not a string")
-		return
-	}
-	databaseString, ok := source.Configuration["database"].(string)
-	if !ok {
-		fmt.Println("database is not a string")
-		return
-	}
-	passwordString, ok := source.Configuration["password"].(string)
-	if !ok {
-		fmt.Println("password is not a string")
-		return
-	}
-	warehouseString, ok := source.Configuration["warehouse"].(string)
-	if !ok {
-		fmt.Println("warehouse is not a string")
+	// Convert configuration from Go types to Terraform types. Secrets are
+	// write-only and never read back, whether the API returns or redacts them.
+	configuration, err := hightouch.ParseSnowflakeConfiguration(source.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source", fmt.Sprintf("Could not decode the configuration of source %d: %s", sourceID, err.Error()))
 		return
 	}
-
-	state.Account = types.StringValue(accountString)
-	state.Port = types.Int64Value(int64(portFloat))
-	state.Username = types.StringValue(usernameString)
-	state.Database = types.StringValue(databaseString)
-	state.Password = types.StringValue(passwordString)
-	state.Warehouse = types.StringValue(warehouseString)
+	state.Account = types.StringValue(configuration.Account)
+	state.Port = types.Int64PointerValue(configuration.Port)
+	state.Username = types.StringValue(configuration.Username)
+	state.Database = types.StringValue(configuration.Database)
+	state.Warehouse = types.StringValue(configuration.Warehouse)
+	state.AuthMethod = types.StringValue(authMethods.Name(configuration.AuthMethod))
+	state.OAuthClientID = oauthClientID(configuration)
+	state.Role = types.StringPointerValue(configuration.Role)
+	state.Schema = types.StringPointerValue(configuration.Schema)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -213,25 +232,44 @@ func (r *SnowflakeSourceResource) Update(
 		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before updating. Please ensure the resource has been created successfully before attempting to update it.")
 		return
 	}
-	fmt.Printf("SourceID: %+v\n", sourceID)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	fmt.Printf("Source Payload: %+v\n", plan)
-	fmt.Printf("Source Payload: %+v\n", state)
+	tflog.Debug(ctx, "Updating Snowflake source", map[string]interface{}{
+		"source_id": sourceID,
+	})
 
 	// Convert configuration from Terraform types to Go types
 	config := make(map[string]interface{})
-	config["port"] = plan.Port.ValueInt64()
+	config["port"] = plan.Port.ValueInt64Pointer()
 	config["account"] = plan.Account.ValueString()
 	config["username"] = plan.Username.ValueString()
 	config["database"] = plan.Database.ValueString()
 	config["warehouse"] = plan.Warehouse.ValueString()
+	config["role"] = optionalString(plan.Role)
+	config["schema"] = optionalString(plan.Schema)
+
+	// The API keeps stored secrets when they are omitted, so they are only
+	// sent when the auth method or the version of its secret changes
+	sendSecrets, diags := authMethods.SecretsChanged(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	auth, diags := authConfiguration(ctx, req.Config, plan, sendSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range auth {
+		config[key] = value
+	}
 
 	// Call the API to update the source
 	source, err := r.client.UpdateHightouchSource(
+		ctx,
 		sourceID,
 		plan.Name.ValueString(),
 		config,
@@ -247,6 +285,7 @@ func (r *SnowflakeSourceResource) Update(
 	plan.ID = types.Int64Value(int64(sourceID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, source.WorkspaceID, source.Slug)...)
 }
 
 // Delete deletes the resource from the remote API.
@@ -262,29 +301,35 @@ func (r *SnowflakeSourceResource) Delete(
 		return
 	}
 
-	// To complete this, a `DeleteSource` method would be needed on the client.
-	// For example:
-	// err := r.client.DeleteSource(int(state.ID.ValueInt64()))
-	// if err != nil {
-	// 	 resp.Diagnostics.AddError("Error deleting source", "Could not delete source, unexpected error: "+err.Error())
-	// 	 return
-	// }
-
-	// For now, we'll simulate a successful deletion.
-	// In a real implementation, you would call your client's delete method here.
-	resp.Diagnostics.AddWarning("Delete not implemented", "The Hightouch API does not currently support deleting sources via the API. The resource will be removed from Terraform state, but not from Hightouch.")
+	sourceID := int(state.ID.ValueInt64())
+	if sourceID == 0 {
+		resp.Diagnostics.AddError("Invalid Source ID", "The source ID must be set before deleting.")
+		return
+	}
+
+	// A source that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchSource(ctx, sourceID)
+	var dependentsErr *hightouch.DependentObjectsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			"Source Has Dependent Models",
+			fmt.Sprintf("Source %d cannot be deleted while models still reference it. Delete those models first. If they are managed by Terraform, make sure they reference this source through its id attribute (e.g. source_id = <this resource>.id) so Terraform destroys them before it.\n\n%s", sourceID, dependentsErr.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting source", "Could not delete source, unexpected error: "+err.Error())
+		return
+	}
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports the resource into Terraform state. The source can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
 func (r *SnowflakeSourceResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID for Import", "ID must be a valid integer.")
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	identity.ImportSource(ctx, r.client, sourceType, req, resp)
 }
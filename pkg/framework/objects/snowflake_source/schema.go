@@ -1,14 +1,21 @@
 package snowflake_source
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// sourceType is the type of the sources managed by this package.
+const sourceType = "snowflake"
+
 var SnowflakeSourceResourceSchema = schema.Schema{
+	// Version 1 made password write-only.
+	Version:     1,
 	Description: "Represents a Hightouch Source, which is a connector to a data warehouse, database, or other data platform.",
 	Attributes: map[string]schema.Attribute{
 		"id": schema.Int64Attribute{
@@ -20,13 +27,16 @@ var SnowflakeSourceResourceSchema = schema.Schema{
 			Required:    true,
 		},
 		"slug": schema.StringAttribute{
-			Description: "The slug of the source.",
+			Description: "The slug of the source. Slugs cannot be changed in place, so changing it replaces the source.",
 			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 		"type": schema.StringAttribute{
 			Description: "The type of the source, 'snowflake'.",
 			Computed:    true,
-			Default:     stringdefault.StaticString("snowflake"),
+			Default:     stringdefault.StaticString(sourceType),
 		},
 		"account": schema.StringAttribute{
 			Description: "Source account.",
@@ -42,10 +52,62 @@ var SnowflakeSourceResourceSchema = schema.Schema{
 			Description: "Username.",
 			Required:    true,
 		},
+		"auth_method": schema.StringAttribute{
+			Description: "How Hightouch authenticates to Snowflake: `password` (the default), `key_pair` or `oauth`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(authMethodPassword),
+			Validators: []validator.String{
+				stringvalidator.OneOf(authMethodPassword, authMethodKeyPair, authMethodOAuth),
+			},
+		},
 		"password": schema.StringAttribute{
-			Description: "Password.",
-			Required:    true,
+			Description: "Password, required when auth_method is `password`. Write-only: it is never stored in state, so change password_wo_version to send a new password. Requires Terraform 1.11 or later.",
+			Optional:    true,
 			Sensitive:   true, // Mark as sensitive to avoid logging
+			WriteOnly:   true,
+		},
+		"password_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current password to Hightouch, for example after rotating it.",
+			Optional:    true,
+		},
+		"private_key": schema.StringAttribute{
+			Description: "PEM-encoded private key of the Snowflake user, required when auth_method is `key_pair`. Write-only: change private_key_wo_version to send a new key.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"private_key_passphrase": schema.StringAttribute{
+			Description: "Passphrase of an encrypted private_key. Write-only, and sent together with private_key.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"private_key_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current private_key and private_key_passphrase to Hightouch.",
+			Optional:    true,
+		},
+		"oauth_client_id": schema.StringAttribute{
+			Description: "Client ID of the Snowflake OAuth security integration, required when auth_method is `oauth`.",
+			Optional:    true,
+		},
+		"oauth_client_secret": schema.StringAttribute{
+			Description: "Client secret of the Snowflake OAuth security integration, required when auth_method is `oauth`. Write-only: change oauth_client_secret_wo_version to send a new secret.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"oauth_client_secret_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current oauth_client_secret to Hightouch.",
+			Optional:    true,
+		},
+		"role": schema.StringAttribute{
+			Description: "Snowflake role to use. Defaults to the default role of the user.",
+			Optional:    true,
+		},
+		"schema": schema.StringAttribute{
+			Description: "Default schema for queries that do not qualify table names.",
+			Optional:    true,
 		},
 		"database": schema.StringAttribute{
 			Description: "Database name.",
@@ -77,15 +139,18 @@ var SnowflakeSourceDataSourceSchema = datasourceschema.Schema{
 	Description: "Fetches information about a Hightouch Snowflake Source.",
 	Attributes: map[string]datasourceschema.Attribute{
 		"id": datasourceschema.Int64Attribute{
-			Description: "The ID of the source.",
-			Required:    true,
+			Description: "The ID of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
+			Computed:    true,
 		},
 		"name": datasourceschema.StringAttribute{
-			Description: "The name of the source.",
+			Description: "The name of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up; the lookup fails if several sources share the name.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"slug": datasourceschema.StringAttribute{
-			Description: "The slug of the source.",
+			Description: "The slug of the source. Exactly one of `id`, `slug` or `name` must be set to look the source up.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"type": datasourceschema.StringAttribute{
@@ -104,10 +169,21 @@ var SnowflakeSourceDataSourceSchema = datasourceschema.Schema{
 			Description: "Username.",
 			Computed:    true,
 		},
-		"password": datasourceschema.StringAttribute{
-			Description: "Password.",
+		"auth_method": datasourceschema.StringAttribute{
+			Description: "How Hightouch authenticates to Snowflake: `password`, `key_pair` or `oauth`.",
+			Computed:    true,
+		},
+		"oauth_client_id": datasourceschema.StringAttribute{
+			Description: "Client ID of the Snowflake OAuth security integration, when auth_method is `oauth`.",
+			Computed:    true,
+		},
+		"role": datasourceschema.StringAttribute{
+			Description: "Snowflake role.",
+			Computed:    true,
+		},
+		"schema": datasourceschema.StringAttribute{
+			Description: "Default schema.",
 			Computed:    true,
-			Sensitive:   true,
 		},
 		"database": datasourceschema.StringAttribute{
 			Description: "Database name.",
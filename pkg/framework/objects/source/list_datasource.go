@@ -0,0 +1,118 @@
+package source
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// SourcesDataSource is the data source implementation for listing sources.
+type SourcesDataSource struct {
+	client *hightouch.Client
+}
+
+// NewSourcesDataSource is a helper function to simplify data source server allocation.
+func NewSourcesDataSource() datasource.DataSource {
+	return &SourcesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SourcesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sources"
+}
+
+// Schema defines the schema for the data source.
+func (d *SourcesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = SourcesDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SourcesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SourcesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config SourcesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := helper.NewNameFilter(config.NameRegex.ValueString(), config.SlugPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		return
+	}
+
+	sources, err := d.client.ListHightouchSources(ctx, hightouch.ListOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing sources", "Could not list sources, unexpected error: "+err.Error())
+		return
+	}
+
+	config.Sources = []SourceSummaryModel{}
+	for _, source := range sources {
+		if !filter.Match(source.Name, source.Slug) {
+			continue
+		}
+		if !config.Type.IsNull() && source.Type != config.Type.ValueString() {
+			continue
+		}
+
+		config.Sources = append(config.Sources, SourceSummaryModel{
+			ID:          types.Int64Value(int64(*source.ID)),
+			Name:        types.StringValue(source.Name),
+			Slug:        types.StringValue(source.Slug),
+			Type:        types.StringValue(source.Type),
+			WorkspaceID: types.Int64Value(int64(source.WorkspaceID)),
+			CreatedAt:   types.StringValue(source.CreatedAt.String()),
+			UpdatedAt:   types.StringValue(source.UpdatedAt.String()),
+		})
+	}
+
+	// The API does not promise an order, so sort by ID for a stable list
+	slices.SortFunc(config.Sources, func(a, b SourceSummaryModel) int {
+		return cmp.Compare(a.ID.ValueInt64(), b.ID.ValueInt64())
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
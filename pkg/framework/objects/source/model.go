@@ -0,0 +1,28 @@
+package source
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/generic"
+)
+
+// SourceResourceModel maps the resource schema data for a source of any type in Hightouch.
+type SourceResourceModel = generic.Model
+
+// SourcesDataSourceModel maps the schema data for the hightouch_sources data source.
+type SourcesDataSourceModel struct {
+	NameRegex  types.String         `tfsdk:"name_regex"`
+	SlugPrefix types.String         `tfsdk:"slug_prefix"`
+	Type       types.String         `tfsdk:"type"`
+	Sources    []SourceSummaryModel `tfsdk:"sources"`
+}
+
+// SourceSummaryModel maps a single source returned by the hightouch_sources data source.
+type SourceSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Type        types.String `tfsdk:"type"`
+	WorkspaceID types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
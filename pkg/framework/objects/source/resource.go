@@ -0,0 +1,75 @@
+package source
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-hightouch/pkg/framework/generic"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// SourceResource is the resource implementation. Everything but its name and
+// schema is shared with hightouch_destination.
+type SourceResource struct {
+	generic.Resource
+}
+
+// NewSourceResource is a helper function to simplify resource server allocation.
+func NewSourceResource() resource.Resource {
+	return &SourceResource{generic.Resource{Kind: sourceKind}}
+}
+
+// Metadata returns the resource type name.
+func (r *SourceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_source"
+}
+
+// Schema defines the schema for the resource.
+func (r *SourceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = SourceResourceSchema
+}
+
+var sourceKind = generic.Kind{
+	Name:               "source",
+	Title:              "Source",
+	Dependents:         "models",
+	ReferenceAttribute: "source_id",
+	Get: func(ctx context.Context, client *hightouch.Client, id int) (*generic.Object, error) {
+		return fromSource(client.GetHightouchSource(ctx, id))
+	},
+	FindBySlug: func(ctx context.Context, client *hightouch.Client, slug string) (*generic.Object, error) {
+		return fromSource(client.FindHightouchSource(ctx, hightouch.ListOptions{Slug: slug}))
+	},
+	Create: func(ctx context.Context, client *hightouch.Client, name, slug, sourceType string, configuration map[string]interface{}) (*generic.Object, error) {
+		return fromSource(client.CreateHightouchSource(ctx, name, slug, sourceType, configuration))
+	},
+	Update: func(ctx context.Context, client *hightouch.Client, id int, name string, configuration map[string]interface{}) (*generic.Object, error) {
+		return fromSource(client.UpdateHightouchSource(ctx, id, name, configuration))
+	},
+	Delete: func(ctx context.Context, client *hightouch.Client, id int) error {
+		return client.DeleteHightouchSource(ctx, id)
+	},
+}
+
+func fromSource(source *hightouch.HightouchSource, err error) (*generic.Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &generic.Object{
+		ID:            *source.ID,
+		Name:          source.Name,
+		Slug:          source.Slug,
+		Type:          source.Type,
+		WorkspaceID:   source.WorkspaceID,
+		CreatedAt:     source.CreatedAt,
+		UpdatedAt:     source.UpdatedAt,
+		Configuration: source.Configuration,
+	}, nil
+}
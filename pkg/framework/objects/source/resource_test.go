@@ -0,0 +1,84 @@
+package source_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccSourceResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	var sourceID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_source", server.HasSource),
+		// Credentials are a write-only attribute.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSourceConfig("Postgres", "hunter2", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_source.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_source.test", "type", "postgres"),
+					resource.TestCheckNoResourceAttr("hightouch_source.test", "credentials"),
+					acctest.StoreID("hightouch_source.test", &sourceID),
+					acctest.CheckSourceConfiguration(server, "hightouch_source.test", "password", "hunter2"),
+				),
+			},
+			{
+				// The imported configuration has every key the API returns
+				// except the password.
+				ResourceName:            "hightouch_source.test",
+				ImportState:             true,
+				ImportStateId:           "slug:acc-postgres",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_version"},
+			},
+			{
+				// Keys added by the API are not drift.
+				PreConfig: func() {
+					server.SetSourceConfiguration(sourceID, map[string]interface{}{"sslMode": "prefer"})
+				},
+				Config:   acctest.ProviderConfig(server) + testAccSourceConfig("Postgres", "hunter2", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSourceConfig("Analytics Postgres", "correct-horse", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_source.test", "name", "Analytics Postgres"),
+					acctest.CheckSourceConfiguration(server, "hightouch_source.test", "password", "correct-horse"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceConfig(name, password string, credentialsVersion int) string {
+	return fmt.Sprintf(`
+resource "hightouch_source" "test" {
+  name = %q
+  slug = "acc-postgres"
+  type = "postgres"
+
+  configuration = jsonencode({
+    host     = "db.internal"
+    port     = 5432
+    database = "analytics"
+    user     = "loader"
+  })
+
+  credentials = {
+    password = %q
+  }
+  credentials_version = %d
+}
+`, name, password, credentialsVersion)
+}
@@ -0,0 +1,123 @@
+package source
+
+import (
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
+)
+
+var SourceResourceSchema = schema.Schema{
+	Description: "Represents a Hightouch Source of any type. Non-secret settings go in configuration and secrets in the write-only credentials attribute.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the source.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the source.",
+			Required:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "The slug of the source. Slugs cannot be changed in place, so changing it replaces the source.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the source (e.g., 'snowflake', 'bigquery', 'postgres', 'databricks'). Changing it replaces the source.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"configuration": schema.StringAttribute{
+			Description: "JSON object with the non-secret configuration of the source, as accepted by the Hightouch API for its type. Keys the API adds that are not set here are ignored.",
+			CustomType:  jsontype.NormalizedType{},
+			Required:    true,
+		},
+		"credentials": schema.MapAttribute{
+			Description: "Secret configuration values, such as passwords or keys, merged into the configuration sent to the API. Write-only: it is never stored in state, so change credentials_version to send new values. Requires Terraform 1.11 or later.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"credentials_version": schema.Int64Attribute{
+			Description: "Change this value to send the current credentials to Hightouch, for example after rotating a password.",
+			Optional:    true,
+		},
+		"workspace_id": schema.Int64Attribute{
+			Description: "The ID of the workspace that the source belongs to.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The timestamp when the source was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The timestamp when the source was last updated.",
+			Computed:    true,
+		},
+	},
+}
+
+var SourcesDataSourceSchema = datasourceschema.Schema{
+	Description: "Lists Hightouch Sources of any type, optionally filtered.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"name_regex": datasourceschema.StringAttribute{
+			Description: "A regular expression that source names must match.",
+			Optional:    true,
+		},
+		"slug_prefix": datasourceschema.StringAttribute{
+			Description: "A prefix that source slugs must start with.",
+			Optional:    true,
+		},
+		"type": datasourceschema.StringAttribute{
+			Description: "Only return sources of this type (e.g., 'snowflake').",
+			Optional:    true,
+		},
+		"sources": datasourceschema.ListNestedAttribute{
+			Description: "The matching sources, ordered by ID.",
+			Computed:    true,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: map[string]datasourceschema.Attribute{
+					"id": datasourceschema.Int64Attribute{
+						Description: "The ID of the source.",
+						Computed:    true,
+					},
+					"name": datasourceschema.StringAttribute{
+						Description: "The name of the source.",
+						Computed:    true,
+					},
+					"slug": datasourceschema.StringAttribute{
+						Description: "The slug of the source.",
+						Computed:    true,
+					},
+					"type": datasourceschema.StringAttribute{
+						Description: "The type of the source.",
+						Computed:    true,
+					},
+					"workspace_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the workspace that the source belongs to.",
+						Computed:    true,
+					},
+					"created_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the source was created.",
+						Computed:    true,
+					},
+					"updated_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the source was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,178 @@
+package sync
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// defaultMappingType is the mapping type Hightouch assumes when none is set.
+const defaultMappingType = "standard"
+
+// expandConfiguration builds the configuration object sent to the API from
+// the typed attributes and the raw configuration JSON.
+func expandConfiguration(model SyncResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := rawConfiguration(model)
+	if err != nil {
+		diags.AddAttributeError(path.Root("configuration"), "Invalid Configuration JSON", "Could not parse configuration JSON: "+err.Error())
+		return nil, diags
+	}
+
+	configuration := hightouch.SyncConfiguration{
+		Mode:           model.Mode.ValueString(),
+		Mappings:       expandMappings(model.Mappings),
+		CustomMappings: expandMappings(model.CustomMappings),
+		DeleteMode:     model.OnDelete.ValueString(),
+		Extra:          raw,
+	}
+	if model.Identifier != nil {
+		identifier := expandMapping(*model.Identifier)
+		configuration.Identifier = &identifier
+	}
+
+	result, err := configuration.Map()
+	if err != nil {
+		diags.AddAttributeError(path.Root("configuration"), "Conflicting Sync Configuration", err.Error())
+		return nil, diags
+	}
+	return result, diags
+}
+
+// flattenConfiguration updates the typed attributes and the raw
+// configuration JSON of model from the configuration returned by the API.
+//
+// A typed key that the prior configuration JSON sets is left there, so syncs
+// written before the typed attributes existed keep their plan. When the
+// configuration JSON is null only the typed keys are read, and mode and
+// on_delete are only read when set in model, unless the sync was just
+// imported, in which case every key is read and the remaining keys go to
+// configuration. Otherwise configuration only keeps the keys it already has.
+func flattenConfiguration(model *SyncResourceModel, configuration map[string]interface{}, imported bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parsed, err := hightouch.ParseSyncConfiguration(configuration)
+	if err != nil {
+		diags.AddError("Invalid Sync Configuration", "Could not read the sync configuration returned by Hightouch: "+err.Error())
+		return diags
+	}
+	prior, err := rawConfiguration(*model)
+	if err != nil {
+		diags.AddAttributeError(path.Root("configuration"), "Invalid Configuration JSON", "Could not parse configuration JSON in state: "+err.Error())
+		return diags
+	}
+	// Keys Hightouch adds to the configuration, such as defaults, are only
+	// read on import, so that they do not show as a diff on every plan
+	raw := map[string]interface{}{}
+	if prior != nil || imported {
+		for key, value := range helper.ProjectKeys(parsed.Extra, prior) {
+			raw[key] = value
+		}
+	}
+	// inRaw reports whether key is managed through the configuration JSON,
+	// copying its value there if so.
+	inRaw := func(key string) bool {
+		if _, ok := prior[key]; !ok {
+			return false
+		}
+		if value, ok := configuration[key]; ok {
+			raw[key] = value
+		}
+		return true
+	}
+
+	// managed reports whether an optional typed attribute is set in
+	// Terraform, so that a value Hightouch defaults is not read into an
+	// attribute the configuration leaves unset.
+	managed := func(prior types.String) bool {
+		return imported || !prior.IsNull()
+	}
+
+	managedMode := managed(model.Mode)
+	model.Mode = types.StringNull()
+	if !inRaw(hightouch.SyncModeKey) && managedMode && parsed.Mode != "" {
+		model.Mode = types.StringValue(parsed.Mode)
+	}
+	managedOnDelete := managed(model.OnDelete)
+	model.OnDelete = types.StringNull()
+	if !inRaw(hightouch.SyncDeleteModeKey) && managedOnDelete && parsed.DeleteMode != "" {
+		model.OnDelete = types.StringValue(parsed.DeleteMode)
+	}
+	var priorIdentifier []SyncMappingModel
+	if model.Identifier != nil {
+		priorIdentifier = []SyncMappingModel{*model.Identifier}
+	}
+	model.Identifier = nil
+	if !inRaw(hightouch.SyncIdentifierKey) && parsed.Identifier != nil {
+		model.Identifier = &flattenMappings([]hightouch.SyncMapping{*parsed.Identifier}, priorIdentifier)[0]
+	}
+	priorMappings, priorCustomMappings := model.Mappings, model.CustomMappings
+	model.Mappings = []SyncMappingModel{}
+	if !inRaw(hightouch.SyncMappingsKey) {
+		model.Mappings = flattenMappings(parsed.Mappings, priorMappings)
+	}
+	model.CustomMappings = []SyncMappingModel{}
+	if !inRaw(hightouch.SyncCustomMappingsKey) {
+		model.CustomMappings = flattenMappings(parsed.CustomMappings, priorCustomMappings)
+	}
+
+	if prior == nil && len(raw) == 0 {
+		model.Configuration = jsontype.NewNormalizedNull()
+		return diags
+	}
+	configJSON, err := json.Marshal(raw)
+	if err != nil {
+		diags.AddError("Error marshaling configuration", "Could not marshal configuration to JSON: "+err.Error())
+		return diags
+	}
+	model.Configuration = jsontype.NewNormalizedValue(string(configJSON))
+	return diags
+}
+
+// rawConfiguration decodes the configuration JSON of model. It returns nil if
+// the configuration is not set.
+func rawConfiguration(model SyncResourceModel) (map[string]interface{}, error) {
+	if model.Configuration.IsNull() || model.Configuration.IsUnknown() {
+		return nil, nil
+	}
+	return helper.DecodeJSONObject(model.Configuration.ValueString())
+}
+
+func expandMappings(models []SyncMappingModel) []hightouch.SyncMapping {
+	var mappings []hightouch.SyncMapping
+	for _, model := range models {
+		mappings = append(mappings, expandMapping(model))
+	}
+	return mappings
+}
+
+func expandMapping(model SyncMappingModel) hightouch.SyncMapping {
+	return hightouch.SyncMapping{
+		From: model.From.ValueString(),
+		To:   model.To.ValueString(),
+		Type: model.Type.ValueString(),
+	}
+}
+
+// flattenMappings converts mappings returned by the API. A mapping type that
+// is unset in prior stays unset when Hightouch reports the default type.
+func flattenMappings(mappings []hightouch.SyncMapping, prior []SyncMappingModel) []SyncMappingModel {
+	models := make([]SyncMappingModel, 0, len(mappings))
+	for i, mapping := range mappings {
+		mappingType := types.StringValue(mapping.Type)
+		if mapping.Type == "" || (mapping.Type == defaultMappingType && i < len(prior) && prior[i].Type.IsNull()) {
+			mappingType = types.StringNull()
+		}
+		models = append(models, SyncMappingModel{
+			From: types.StringValue(mapping.From),
+			To:   types.StringValue(mapping.To),
+			Type: mappingType,
+		})
+	}
+	return models
+}
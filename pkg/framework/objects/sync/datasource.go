@@ -3,8 +3,11 @@ package sync
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
@@ -59,27 +62,49 @@ func (d *SyncDataSource) Configure(
 	d.client = client
 }
 
+// ConfigValidators requires exactly one of id, slug or name to be configured.
+func (d *SyncDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *SyncDataSource) Read(
 	ctx context.Context,
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var config SyncResourceModel
+	var config SyncDataSourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Get sync from Hightouch API
-	syncID := int(config.ID.ValueInt64())
-	if syncID == 0 {
-		resp.Diagnostics.AddError("Invalid Sync ID", "The sync ID must be provided.")
-		return
+	// Look the sync up by whichever of id, slug or name is configured
+	var sync *hightouch.HightouchSync
+	var err error
+	if !config.ID.IsNull() {
+		sync, err = d.client.GetHightouchSync(ctx, int(config.ID.ValueInt64()))
+	} else {
+		sync, err = d.client.FindHightouchSync(ctx, hightouch.ListOptions{
+			Name: config.Name.ValueString(),
+			Slug: config.Slug.ValueString(),
+		})
 	}
 
-	sync, err := d.client.GetHightouchSync(syncID)
+	var lookupErr *hightouch.LookupError
+	if errors.As(err, &lookupErr) {
+		resp.Diagnostics.AddAttributeError(path.Root(lookupErr.Field), "Sync Lookup Failed", lookupErr.Error())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading sync", "Could not read sync, unexpected error: "+err.Error())
 		return
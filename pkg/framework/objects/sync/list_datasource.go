@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// SyncsDataSource is the data source implementation for listing syncs.
+type SyncsDataSource struct {
+	client *hightouch.Client
+}
+
+// NewSyncsDataSource is a helper function to simplify data source server allocation.
+func NewSyncsDataSource() datasource.DataSource {
+	return &SyncsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SyncsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_syncs"
+}
+
+// Schema defines the schema for the data source.
+func (d *SyncsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = SyncsDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SyncsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SyncsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config SyncsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := helper.NewNameFilter(config.NameRegex.ValueString(), config.SlugPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		return
+	}
+
+	// The model filter is applied by the API, the rest locally
+	syncs, err := d.client.ListHightouchSyncs(ctx, hightouch.ListSyncsOptions{
+		ModelID: int(config.ModelID.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing syncs", "Could not list syncs, unexpected error: "+err.Error())
+		return
+	}
+
+	// Syncs have no source of their own, so the source filter matches the
+	// syncs of every model that queries the source
+	var sourceModelIDs map[int]bool
+	if !config.SourceID.IsNull() {
+		models, err := d.client.ListHightouchModels(ctx, hightouch.ListOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing models", "Could not list models, unexpected error: "+err.Error())
+			return
+		}
+		sourceModelIDs = make(map[int]bool)
+		for _, model := range models {
+			if int64(model.SourceID) == config.SourceID.ValueInt64() {
+				sourceModelIDs[*model.ID] = true
+			}
+		}
+	}
+
+	config.Syncs = []SyncSummaryModel{}
+	for _, sync := range syncs {
+		if !filter.Match(sync.Name, sync.Slug) {
+			continue
+		}
+		if sourceModelIDs != nil && !sourceModelIDs[sync.ModelID] {
+			continue
+		}
+		if !config.DestinationID.IsNull() && int64(sync.DestinationID) != config.DestinationID.ValueInt64() {
+			continue
+		}
+		if !config.Disabled.IsNull() && sync.Disabled != config.Disabled.ValueBool() {
+			continue
+		}
+
+		config.Syncs = append(config.Syncs, SyncSummaryModel{
+			ID:            types.Int64Value(int64(*sync.ID)),
+			Name:          types.StringValue(sync.Name),
+			Slug:          types.StringValue(sync.Slug),
+			DestinationID: types.Int64Value(int64(sync.DestinationID)),
+			ModelID:       types.Int64Value(int64(sync.ModelID)),
+			Status:        types.StringValue(sync.Status),
+			Disabled:      types.BoolValue(sync.Disabled),
+			WorkspaceID:   types.Int64Value(int64(sync.WorkspaceID)),
+			CreatedAt:     types.StringValue(sync.CreatedAt.String()),
+			UpdatedAt:     types.StringValue(sync.UpdatedAt.String()),
+		})
+	}
+
+	// The API does not promise an order, so sort by ID for a stable list
+	slices.SortFunc(config.Syncs, func(a, b SyncSummaryModel) int {
+		return cmp.Compare(a.ID.ValueInt64(), b.ID.ValueInt64())
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,10 +2,74 @@ package sync
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
 )
 
 // SyncResourceModel maps the resource schema data for a Hightouch sync.
 type SyncResourceModel struct {
+	ID             types.Int64         `tfsdk:"id"`
+	Name           types.String        `tfsdk:"name"`
+	Slug           types.String        `tfsdk:"slug"`
+	DestinationID  types.Int64         `tfsdk:"destination_id"`
+	ModelID        types.Int64         `tfsdk:"model_id"`
+	SourceID       types.Int64         `tfsdk:"source_id"`
+	Mode           types.String        `tfsdk:"mode"`
+	Identifier     *SyncMappingModel   `tfsdk:"identifier"`
+	Mappings       []SyncMappingModel  `tfsdk:"mapping"`
+	CustomMappings []SyncMappingModel  `tfsdk:"custom_mapping"`
+	OnDelete       types.String        `tfsdk:"on_delete"`
+	Configuration  jsontype.Normalized `tfsdk:"configuration"`
+	Schedule       *SyncScheduleModel  `tfsdk:"schedule"`
+	Status         types.String        `tfsdk:"status"`
+	Disabled       types.Bool          `tfsdk:"disabled"`
+	WorkspaceID    types.Int64         `tfsdk:"workspace_id"`
+	CreatedAt      types.String        `tfsdk:"created_at"`
+	UpdatedAt      types.String        `tfsdk:"updated_at"`
+}
+
+// SyncMappingModel maps a model column to a destination field, used by the
+// identifier attribute and the mapping and custom_mapping blocks.
+type SyncMappingModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+	Type types.String `tfsdk:"type"`
+}
+
+// SyncScheduleModel maps the schedule attribute of a sync. Exactly one of
+// its attributes is set.
+type SyncScheduleModel struct {
+	Interval *SyncIntervalModel `tfsdk:"interval"`
+	Cron     *SyncCronModel     `tfsdk:"cron"`
+	Visual   *SyncVisualModel   `tfsdk:"visual"`
+	DBTCloud *SyncDBTCloudModel `tfsdk:"dbt_cloud"`
+	Manual   types.Bool         `tfsdk:"manual"`
+}
+
+// SyncIntervalModel maps an interval schedule.
+type SyncIntervalModel struct {
+	Quantity types.Int64  `tfsdk:"quantity"`
+	Unit     types.String `tfsdk:"unit"`
+}
+
+// SyncCronModel maps a cron schedule.
+type SyncCronModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+// SyncVisualModel maps a schedule on given days of the week and times of day.
+type SyncVisualModel struct {
+	Days  []types.String `tfsdk:"days"`
+	Times []types.String `tfsdk:"times"`
+}
+
+// SyncDBTCloudModel maps a schedule that runs after a dbt Cloud job.
+type SyncDBTCloudModel struct {
+	JobID types.String `tfsdk:"job_id"`
+}
+
+// SyncDataSourceModel maps the schema data for the hightouch_sync data source,
+// which exposes the configuration and schedule as JSON.
+type SyncDataSourceModel struct {
 	ID            types.Int64  `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
 	Slug          types.String `tfsdk:"slug"`
@@ -20,3 +84,28 @@ type SyncResourceModel struct {
 	CreatedAt     types.String `tfsdk:"created_at"`
 	UpdatedAt     types.String `tfsdk:"updated_at"`
 }
+
+// SyncsDataSourceModel maps the schema data for the hightouch_syncs data source.
+type SyncsDataSourceModel struct {
+	NameRegex     types.String       `tfsdk:"name_regex"`
+	SlugPrefix    types.String       `tfsdk:"slug_prefix"`
+	ModelID       types.Int64        `tfsdk:"model_id"`
+	SourceID      types.Int64        `tfsdk:"source_id"`
+	DestinationID types.Int64        `tfsdk:"destination_id"`
+	Disabled      types.Bool         `tfsdk:"disabled"`
+	Syncs         []SyncSummaryModel `tfsdk:"syncs"`
+}
+
+// SyncSummaryModel maps a single sync returned by the hightouch_syncs data source.
+type SyncSummaryModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Slug          types.String `tfsdk:"slug"`
+	DestinationID types.Int64  `tfsdk:"destination_id"`
+	ModelID       types.Int64  `tfsdk:"model_id"`
+	Status        types.String `tfsdk:"status"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	WorkspaceID   types.Int64  `tfsdk:"workspace_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
@@ -0,0 +1,144 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// ModifyPlan checks, once their IDs are known, that the model and destination
+// of the sync exist and fit its configuration, and fills in source_id from the
+// model when it is not configured. To save API calls, the checks only run when
+// the sync is created, when one of model_id, destination_id, mode and
+// source_id changes, or when Read found the model missing.
+func (r *SyncResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to check when the sync is destroyed or before the provider is
+	// configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan SyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var configSourceID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_id"), &configSourceID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip the lookups when none of the references changed; Read keeps
+	// source_id in step with the model
+	if !req.State.Raw.IsNull() {
+		var state SyncResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		modelMissing, diags := req.Private.GetKey(ctx, modelMissingKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if modelMissing == nil &&
+			plan.ModelID.Equal(state.ModelID) && plan.DestinationID.Equal(state.DestinationID) && plan.Mode.Equal(state.Mode) &&
+			(configSourceID.IsNull() || configSourceID.Equal(state.SourceID)) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_id"), state.SourceID)...)
+			return
+		}
+	}
+
+	if !plan.ModelID.IsUnknown() {
+		sourceID, diags := r.modelSourceID(ctx, plan.ModelID, configSourceID)
+		resp.Diagnostics.Append(diags...)
+		if !sourceID.IsNull() && !configSourceID.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_id"), sourceID)...)
+		}
+	}
+	if !plan.DestinationID.IsUnknown() {
+		resp.Diagnostics.Append(r.checkDestination(ctx, plan.DestinationID, plan.Mode)...)
+	}
+}
+
+// modelSourceID reads the model of a sync and returns the source it queries.
+// It reports an error if the model does not exist or if configSourceID is set
+// to a different source.
+func (r *SyncResource) modelSourceID(
+	ctx context.Context,
+	modelID types.Int64,
+	configSourceID types.Int64,
+) (types.Int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model, err := r.client.GetHightouchModel(ctx, int(modelID.ValueInt64()))
+	if hightouch.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("model_id"),
+			"Model Not Found",
+			fmt.Sprintf("Model %d does not exist in Hightouch. It may have been deleted outside of Terraform.", modelID.ValueInt64()),
+		)
+		return types.Int64Null(), diags
+	}
+	if err != nil {
+		diags.AddError("Error reading model", "Could not read the model of the sync, unexpected error: "+err.Error())
+		return types.Int64Null(), diags
+	}
+
+	sourceID := types.Int64Value(int64(model.SourceID))
+	if !configSourceID.IsNull() && !configSourceID.IsUnknown() && !configSourceID.Equal(sourceID) {
+		diags.AddAttributeError(
+			path.Root("source_id"),
+			"Source Does Not Match Model",
+			fmt.Sprintf(
+				"source_id is %d, but model %d queries source %d. Remove source_id to use the source of the model.",
+				configSourceID.ValueInt64(), modelID.ValueInt64(), model.SourceID,
+			),
+		)
+		return types.Int64Null(), diags
+	}
+	return sourceID, diags
+}
+
+// checkDestination reads the destination of a sync and reports an error if it
+// does not exist or does not support mode.
+func (r *SyncResource) checkDestination(
+	ctx context.Context,
+	destinationID types.Int64,
+	mode types.String,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	destination, err := r.client.GetHightouchDestination(ctx, int(destinationID.ValueInt64()))
+	if hightouch.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("destination_id"),
+			"Destination Not Found",
+			fmt.Sprintf("Destination %d does not exist in Hightouch. It may have been deleted outside of Terraform.", destinationID.ValueInt64()),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error reading destination", "Could not read the destination of the sync, unexpected error: "+err.Error())
+		return diags
+	}
+
+	modes := hightouch.SupportedSyncModes(destination.Type)
+	if mode.IsNull() || mode.IsUnknown() || modes == nil || slices.Contains(modes, mode.ValueString()) {
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("mode"),
+		"Unsupported Sync Mode",
+		fmt.Sprintf(
+			"%s destinations do not support the %q mode. Supported modes: %s.",
+			destination.Type, mode.ValueString(), strings.Join(modes, ", "),
+		),
+	)
+	return diags
+}
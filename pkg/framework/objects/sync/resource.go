@@ -2,12 +2,12 @@ package sync
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strconv"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/framework/identity"
 	"terraform-provider-hightouch/pkg/hightouch"
 )
 
@@ -21,6 +21,15 @@ func NewSyncResource() resource.Resource {
 	return &SyncResource{}
 }
 
+// importedKey is the private state key ImportState sets so that the Read that
+// follows an import knows there is no prior state to go by.
+const importedKey = "imported"
+
+// modelMissingKey is the private state key Read sets when the model of the
+// sync no longer exists, so that ModifyPlan checks it even though model_id
+// did not change.
+const modelMissingKey = "model_missing"
+
 // Metadata returns the resource type name.
 func (r *SyncResource) Metadata(
 	_ context.Context,
@@ -39,6 +48,23 @@ func (r *SyncResource) Schema(
 	resp.Schema = SyncResourceSchema
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by slug.
+func (r *SyncResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema
+}
+
+// UpgradeState upgrades the state of syncs written by earlier versions of the
+// schema.
+func (r *SyncResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeScheduleV0},
+	}
+}
+
 // Configure adds the hightouch configured client to the resource.
 func (r *SyncResource) Configure(
 	_ context.Context,
@@ -73,21 +99,19 @@ func (r *SyncResource) Create(
 		return
 	}
 
-	// Parse configuration and schedule JSON strings
-	var configuration map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.Configuration.ValueString()), &configuration); err != nil {
-		resp.Diagnostics.AddError("Invalid Configuration JSON", "Could not parse configuration JSON: "+err.Error())
-		return
-	}
-
-	var schedule map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.Schedule.ValueString()), &schedule); err != nil {
-		resp.Diagnostics.AddError("Invalid Schedule JSON", "Could not parse schedule JSON: "+err.Error())
+	// Build the configuration from the typed attributes and the raw JSON, and
+	// the schedule from the schedule attribute
+	configuration, diags := expandConfiguration(plan)
+	resp.Diagnostics.Append(diags...)
+	schedule, diags := expandSchedule(plan.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Call the API to create the sync
 	sync, err := r.client.CreateHightouchSync(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Slug.ValueString(),
 		int(plan.SourceID.ValueInt64()),
@@ -113,6 +137,7 @@ func (r *SyncResource) Create(
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, sync.WorkspaceID, sync.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,7 +162,16 @@ func (r *SyncResource) Read(
 		resp.Diagnostics.AddError("Invalid Sync ID", "The sync ID must be set before reading. Please ensure the resource has been created successfully before attempting to read it.")
 		return
 	}
-	sync, err := r.client.GetHightouchSync(syncID)
+	sync, err := r.client.GetHightouchSync(ctx, syncID)
+	if hightouch.IsNotFound(err) {
+		// The sync was deleted outside of Terraform; drop it from state so
+		// Terraform plans to recreate it.
+		tflog.Warn(ctx, "Sync no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"sync_id": syncID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading sync", "Could not read sync, unexpected error: "+err.Error())
 		return
@@ -149,16 +183,40 @@ func (r *SyncResource) Read(
 		return
 	}
 
-	// Convert configuration and schedule maps to JSON strings
-	configJSON, err := json.Marshal(sync.Configuration)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling configuration", "Could not marshal configuration to JSON: "+err.Error())
+	// The API does not return the source of a sync; read it from the model.
+	// A model deleted outside of Terraform fails the next plan, so keep the
+	// source in state until then.
+	sourceID := state.SourceID
+	var modelMissing []byte
+	model, err := r.client.GetHightouchModel(ctx, sync.ModelID)
+	switch {
+	case hightouch.IsNotFound(err):
+		modelMissing = []byte("true")
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("model_id"),
+			"Model Not Found",
+			fmt.Sprintf("Model %d of sync %d no longer exists in Hightouch, so source_id could not be refreshed.", sync.ModelID, syncID),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError("Error reading sync", "Could not read the model of the sync, unexpected error: "+err.Error())
 		return
+	default:
+		sourceID = types.Int64Value(int64(model.SourceID))
 	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, modelMissingKey, modelMissing)...)
 
-	scheduleJSON, err := json.Marshal(sync.Schedule)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling schedule", "Could not marshal schedule to JSON: "+err.Error())
+	// ImportState marks the first read after an import, which reads every
+	// configuration key since there is no prior state to go by
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
+
+	// Split the configuration between the typed attributes and the raw JSON,
+	// and convert the schedule
+	resp.Diagnostics.Append(flattenConfiguration(&state, sync.Configuration, imported != nil)...)
+	schedule, diags := flattenSchedule(sync.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -168,8 +226,8 @@ func (r *SyncResource) Read(
 	state.Slug = types.StringValue(sync.Slug)
 	state.DestinationID = types.Int64Value(int64(sync.DestinationID))
 	state.ModelID = types.Int64Value(int64(sync.ModelID))
-	state.Configuration = types.StringValue(string(configJSON))
-	state.Schedule = types.StringValue(string(scheduleJSON))
+	state.SourceID = sourceID
+	state.Schedule = schedule
 	state.Status = types.StringValue(sync.Status)
 	state.Disabled = types.BoolValue(sync.Disabled)
 	state.UpdatedAt = types.StringValue(sync.UpdatedAt.String())
@@ -178,6 +236,7 @@ func (r *SyncResource) Read(
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, sync.WorkspaceID, sync.Slug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -204,21 +263,19 @@ func (r *SyncResource) Update(
 		return
 	}
 
-	// Parse configuration and schedule JSON strings
-	var configuration map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.Configuration.ValueString()), &configuration); err != nil {
-		resp.Diagnostics.AddError("Invalid Configuration JSON", "Could not parse configuration JSON: "+err.Error())
-		return
-	}
-
-	var schedule map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.Schedule.ValueString()), &schedule); err != nil {
-		resp.Diagnostics.AddError("Invalid Schedule JSON", "Could not parse schedule JSON: "+err.Error())
+	// Build the configuration from the typed attributes and the raw JSON, and
+	// the schedule from the schedule attribute
+	configuration, diags := expandConfiguration(plan)
+	resp.Diagnostics.Append(diags...)
+	schedule, diags := expandSchedule(plan.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Call the API to update the sync
 	sync, err := r.client.UpdateHightouchSync(
+		ctx,
 		syncID,
 		plan.Name.ValueString(),
 		configuration,
@@ -237,6 +294,7 @@ func (r *SyncResource) Update(
 	plan.ID = types.Int64Value(int64(syncID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, sync.WorkspaceID, sync.Slug)...)
 }
 
 // Delete deletes the resource from the remote API.
@@ -252,20 +310,50 @@ func (r *SyncResource) Delete(
 		return
 	}
 
-	// For now, we'll simulate a successful deletion since delete is not implemented in the SDK
-	resp.Diagnostics.AddWarning("Delete not implemented", "The Hightouch API does not currently support deleting syncs via the API. The resource will be removed from Terraform state, but not from Hightouch.")
+	syncID := int(state.ID.ValueInt64())
+	if syncID == 0 {
+		resp.Diagnostics.AddError("Invalid Sync ID", "The sync ID must be set before deleting.")
+		return
+	}
+
+	// A sync that has already been deleted outside of Terraform is not an error
+	err := r.client.DeleteHightouchSync(ctx, syncID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting sync", "Could not delete sync, unexpected error: "+err.Error())
+		return
+	}
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports the resource into Terraform state. The sync can be
+// identified by its numeric ID, by "slug:<slug>", by "<workspace_id>/<slug>"
+// or, in import blocks, by its identity.
 func (r *SyncResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.Atoi(req.ID)
+	key, diags := identity.ImportKey(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sync *hightouch.HightouchSync
+	var err error
+	if key.ID != 0 {
+		sync, err = r.client.GetHightouchSync(ctx, key.ID)
+	} else {
+		sync, err = r.client.FindHightouchSync(ctx, hightouch.ListOptions{Slug: key.Slug})
+	}
+	if err == nil {
+		err = key.CheckWorkspace(sync.WorkspaceID)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID for Import", "ID must be a valid integer.")
+		resp.Diagnostics.AddError("Error importing sync", "Could not find the sync to import: "+err.Error())
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *sync.ID)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+	resp.Diagnostics.Append(identity.Set(ctx, resp.Identity, sync.WorkspaceID, sync.Slug)...)
 }
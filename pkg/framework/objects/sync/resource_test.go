@@ -0,0 +1,679 @@
+package sync_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccSyncResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_sync.test", "id"),
+					resource.TestCheckResourceAttrPair("hightouch_sync.test", "model_id", "hightouch_model.test", "id"),
+					resource.TestCheckResourceAttrPair("hightouch_sync.test", "destination_id", "hightouch_iterable_destination.test", "id"),
+					resource.TestCheckResourceAttrPair("hightouch_sync.test", "source_id", "hightouch_snowflake_source.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "status", "pending"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mode", "upsert"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "identifier.to", "userId"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mapping.#", "1"),
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "configuration"),
+				),
+			},
+			{
+				ResourceName:      "hightouch_sync.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable (paused)", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "name", "Users to Iterable (paused)"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_mappings checks that typed mappings and the raw
+// configuration JSON are combined into one configuration object and read
+// back without a diff.
+func TestAccSyncResource_mappings(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncMappingsConfig(`
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+
+  mapping {
+    from = "plan"
+    to   = "pro"
+    type = "static"
+  }
+
+  custom_mapping {
+    from = "segment"
+    to   = "userSegment"
+  }
+
+  configuration = jsonencode({
+    trackUnsubscribes = true
+  })
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mode", "update"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "on_delete", "clear"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mapping.#", "2"),
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "mapping.0.type"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mapping.1.type", "static"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "custom_mapping.0.to", "userSegment"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "configuration", `{"trackUnsubscribes":true}`),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncMappingsConfig(`
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mapping.#", "1"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "custom_mapping.#", "0"),
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "configuration"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_unsetMode checks that removing mode and on_delete from
+// the configuration removes them from the sync.
+func TestAccSyncResource_unsetMode(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncMappingsConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mode", "update"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "on_delete", "clear"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncDependencies() + `
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+
+  identifier = {
+    from = "id"
+    to   = "userId"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "mode"),
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "on_delete"),
+					testAccCheckSyncConfigurationKeys(server, "identifier"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_mappingConflict checks that a key cannot be set both by
+// a typed attribute and in the raw configuration JSON.
+func TestAccSyncResource_mappingConflict(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncMappingsConfig(`
+  configuration = jsonencode({
+    mode = "upsert"
+  })
+`),
+				ExpectError: regexp.MustCompile(`Conflicting Sync Configuration`),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_references checks that source_id is derived from the
+// model and that references that do not fit together fail the plan.
+func TestAccSyncResource_references(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig(server) + testAccSyncReferencesConfig("hightouch_snowflake_source.other.id", "upsert"),
+				ExpectError: regexp.MustCompile(`Source Does Not Match Model`),
+			},
+			{
+				Config:      acctest.ProviderConfig(server) + testAccSyncReferencesConfig("null", "mirror"),
+				ExpectError: regexp.MustCompile(`(?s)Unsupported Sync Mode.*iterable destinations do not support the "mirror" mode`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncReferencesConfig("null", "upsert"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hightouch_sync.test", "source_id", "hightouch_snowflake_source.test", "id"),
+				),
+			},
+			{
+				// Setting source_id to the source of the model is not a change.
+				Config:   acctest.ProviderConfig(server) + testAccSyncReferencesConfig("hightouch_snowflake_source.test.id", "upsert"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_modelDeletedOutsideTerraform checks that a model deleted
+// outside of Terraform does not break refreshing the sync and fails the next
+// plan, even though none of the references of the sync changed.
+func TestAccSyncResource_modelDeletedOutsideTerraform(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	// The model and destination are not managed by Terraform, so deleting
+	// the model does not plan to recreate it.
+	ctx := context.Background()
+	client := hightouch.NewClient(hightouchtest.APIKey, server.URL)
+	source, err := client.CreateHightouchSource(ctx, "Warehouse", "acc-sync-warehouse", "snowflake", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	model, err := client.CreateHightouchModel(ctx, "Users", "acc-sync-users", *source.ID, "select id, email from users", "raw_sql", "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	destination, err := client.CreateHightouchDestination(ctx, "Iterable", "acc-sync-iterable", "iterable", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := acctest.ProviderConfig(server) + fmt.Sprintf(`
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  model_id       = %d
+  destination_id = %d
+  mode           = "upsert"
+
+  identifier = {
+    from = "id"
+    to   = "userId"
+  }
+}
+`, *model.ID, *destination.ID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "source_id", strconv.Itoa(*source.ID)),
+				),
+			},
+			{
+				PreConfig:   func() { server.DeleteModel(*model.ID) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`(?s)Model Not Found.*Model %d does not exist in Hightouch`, *model.ID)),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_rawConfiguration checks that syncs configured entirely
+// through the configuration JSON keep working.
+func TestAccSyncResource_rawConfiguration(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+	var syncID int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncRawConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "configuration", `{"mappings":[{"from":"email","to":"email"}],"mode":"upsert"}`),
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "mode"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "mapping.#", "0"),
+					acctest.StoreID("hightouch_sync.test", &syncID),
+				),
+			},
+			{
+				Config:   acctest.ProviderConfig(server) + testAccSyncRawConfig(),
+				PlanOnly: true,
+			},
+			{
+				// Keys Hightouch adds to the configuration are not drift.
+				PreConfig: func() {
+					server.SetSyncConfiguration(syncID, map[string]interface{}{"batchSize": 100})
+				},
+				Config:   acctest.ProviderConfig(server) + testAccSyncRawConfig(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_configurationFormatting checks that configuration JSON
+// formatted differently from the API response does not show a diff.
+func TestAccSyncResource_configurationFormatting(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	config := acctest.ProviderConfig(server) + testAccSyncMappingsConfig(`
+  configuration = <<-EOT
+    {
+      "trackUnsubscribes": true,
+      "batchSize":         1.0e2
+    }
+  EOT
+`)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("hightouch_sync.test", "id"),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_schedule checks that each kind of schedule is sent to
+// Hightouch and read back without a diff.
+func TestAccSyncResource_schedule(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    interval = {
+      quantity = 6
+      unit     = "hour"
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.interval.quantity", "6"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.interval.unit", "hour"),
+				),
+			},
+			{
+				ResourceName:      "hightouch_sync.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    cron = {
+      expression = "0 6 * * mon-fri"
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hightouch_sync.test", "schedule.interval"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.cron.expression", "0 6 * * mon-fri"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    visual = {
+      days  = ["friday", "monday"]
+      times = ["09:00", "17:30"]
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.visual.days.#", "2"),
+					resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.visual.times.1", "17:30"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    dbt_cloud = {
+      job_id = "4242"
+    }
+`),
+				Check: resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.dbt_cloud.job_id", "4242"),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    manual = true
+`),
+				Check: resource.TestCheckResourceAttr("hightouch_sync.test", "schedule.manual", "true"),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable", false),
+				Check:  resource.TestCheckNoResourceAttr("hightouch_sync.test", "schedule"),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_invalidSchedule checks that invalid schedules are
+// rejected before anything is created.
+func TestAccSyncResource_invalidSchedule(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    cron = {
+      expression = "0 25 * * *"
+    }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Cron Expression`),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncScheduleConfig(`
+    manual = true
+    cron = {
+      expression = "0 6 * * *"
+    }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// TestAccSyncResource_deletedOutsideTerraform checks that a sync deleted in
+// Hightouch is removed from state and recreated instead of failing the plan.
+func TestAccSyncResource_deletedOutsideTerraform(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	var syncID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		CheckDestroy:             acctest.CheckDestroyed("hightouch_sync", server.HasSync),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable", false),
+				Check: func(s *terraform.State) error {
+					id, err := strconv.Atoi(s.RootModule().Resources["hightouch_sync.test"].Primary.ID)
+					syncID = id
+					return err
+				},
+			},
+			{
+				PreConfig: func() { server.DeleteSync(syncID) },
+				Config:    acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable", false),
+				Check: func(s *terraform.State) error {
+					id := s.RootModule().Resources["hightouch_sync.test"].Primary.ID
+					if id == strconv.Itoa(syncID) {
+						return fmt.Errorf("sync %s was not recreated", id)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSyncsDataSource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncConfig("Users to Iterable", true) + `
+data "hightouch_syncs" "paused" {
+  slug_prefix = "acc-users"
+  model_id    = hightouch_sync.test.model_id
+  disabled    = true
+}
+
+data "hightouch_syncs" "active" {
+  disabled = false
+  model_id = hightouch_sync.test.model_id
+}
+
+data "hightouch_syncs" "by_source" {
+  source_id = hightouch_sync.test.source_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hightouch_syncs.paused", "syncs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.hightouch_syncs.paused", "syncs.0.id", "hightouch_sync.test", "id"),
+					resource.TestCheckResourceAttr("data.hightouch_syncs.paused", "syncs.0.name", "Users to Iterable"),
+					resource.TestCheckResourceAttr("data.hightouch_syncs.active", "syncs.#", "0"),
+					resource.TestCheckResourceAttr("data.hightouch_syncs.by_source", "syncs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.hightouch_syncs.by_source", "syncs.0.id", "hightouch_sync.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSyncConfig(name string, disabled bool) string {
+	return testAccSyncDependencies() + fmt.Sprintf(`
+resource "hightouch_sync" "test" {
+  name           = %q
+  slug           = "acc-users-to-iterable"
+  source_id      = hightouch_snowflake_source.test.id
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+  disabled       = %t
+  mode           = "upsert"
+
+  identifier = {
+    from = "id"
+    to   = "userId"
+  }
+
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+}
+`, name, disabled)
+}
+
+// testAccSyncDependencies returns the source, model and destination used by
+// the sync tests.
+func testAccSyncDependencies() string {
+	return `
+resource "hightouch_snowflake_source" "test" {
+  name      = "Warehouse"
+  slug      = "acc-sync-warehouse"
+  account   = "acme"
+  port      = 443
+  username  = "loader"
+  password  = "hunter2"
+  database  = "ANALYTICS"
+  warehouse = "COMPUTE_WH"
+}
+
+resource "hightouch_model" "test" {
+  name        = "Users"
+  slug        = "acc-sync-users"
+  source_id   = hightouch_snowflake_source.test.id
+  sql         = "select id, email from users"
+  primary_key = "id"
+}
+
+resource "hightouch_iterable_destination" "test" {
+  name    = "Iterable"
+  slug    = "acc-sync-iterable"
+  api_key = "iterable-secret"
+}
+`
+}
+
+// testAccSyncMappingsConfig returns the configuration of a sync in update mode
+// with the given mapping blocks and attributes.
+func testAccSyncMappingsConfig(body string) string {
+	return testAccSyncDependencies() + fmt.Sprintf(`
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+  mode           = "update"
+  on_delete      = "clear"
+
+  identifier = {
+    from = "id"
+    to   = "userId"
+  }
+%s}
+`, body)
+}
+
+// testAccSyncReferencesConfig returns the configuration of a sync with the
+// given source_id expression and mode, next to a second source the model does
+// not query.
+func testAccSyncReferencesConfig(sourceID, mode string) string {
+	return testAccSyncDependencies() + fmt.Sprintf(`
+resource "hightouch_snowflake_source" "other" {
+  name      = "Other Warehouse"
+  slug      = "acc-sync-other-warehouse"
+  account   = "acme"
+  port      = 443
+  username  = "loader"
+  password  = "hunter2"
+  database  = "ANALYTICS"
+  warehouse = "COMPUTE_WH"
+}
+
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  source_id      = %s
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+  mode           = %q
+
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+}
+`, sourceID, mode)
+}
+
+func testAccSyncRawConfig() string {
+	return testAccSyncDependencies() + `
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+
+  configuration = jsonencode({
+    mode     = "upsert"
+    mappings = [{ from = "email", to = "email" }]
+  })
+}
+`
+}
+
+// testAccSyncScheduleConfig returns the configuration of a sync with the given
+// schedule attributes.
+func testAccSyncScheduleConfig(schedule string) string {
+	return testAccSyncDependencies() + fmt.Sprintf(`
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-users-to-iterable"
+  source_id      = hightouch_snowflake_source.test.id
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+  disabled       = false
+  mode           = "upsert"
+
+  identifier = {
+    from = "id"
+    to   = "userId"
+  }
+
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+
+  schedule = {
+%s  }
+}
+`, schedule)
+}
+
+// testAccCheckSyncConfigurationKeys checks the keys of the configuration
+// stored by the fake server for hightouch_sync.test.
+func testAccCheckSyncConfigurationKeys(server *hightouchtest.Server, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := strconv.Atoi(s.RootModule().Resources["hightouch_sync.test"].Primary.ID)
+		if err != nil {
+			return err
+		}
+		configuration := server.SyncConfiguration(id)
+		if len(configuration) != len(want) {
+			return fmt.Errorf("sync configuration = %v, want keys %v", configuration, want)
+		}
+		for _, key := range want {
+			if _, ok := configuration[key]; !ok {
+				return fmt.Errorf("sync configuration = %v, want keys %v", configuration, want)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"regexp"
+	"terraform-provider-hightouch/pkg/helper"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// timeOfDayRegexp matches a time of day in HH:MM format.
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// cronExpression validates that a string is a five-field cron expression.
+type cronExpression struct{}
+
+func (v cronExpression) Description(_ context.Context) string {
+	return "value must be a five-field cron expression"
+}
+
+func (v cronExpression) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpression) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := helper.ValidateCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Cron Expression", err.Error())
+	}
+}
+
+// expandSchedule builds the schedule object sent to the API. A sync without a
+// schedule gets an empty object.
+func expandSchedule(model *SyncScheduleModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if model == nil {
+		return map[string]interface{}{}, diags
+	}
+
+	var schedule hightouch.SyncSchedule
+	if model.Interval != nil {
+		schedule.Interval = &hightouch.SyncInterval{
+			Quantity: int(model.Interval.Quantity.ValueInt64()),
+			Unit:     model.Interval.Unit.ValueString(),
+		}
+	}
+	if model.Cron != nil {
+		schedule.Cron = model.Cron.Expression.ValueString()
+	}
+	if model.Visual != nil {
+		schedule.Visual = &hightouch.SyncVisualSchedule{
+			Days:  stringValues(model.Visual.Days),
+			Times: stringValues(model.Visual.Times),
+		}
+	}
+	if model.DBTCloud != nil {
+		schedule.DBTCloudJobID = model.DBTCloud.JobID.ValueString()
+	}
+	if !model.Manual.IsNull() {
+		if !model.Manual.ValueBool() {
+			diags.AddAttributeError(path.Root("schedule").AtName("manual"), "Invalid Schedule", "manual can only be set to true. Remove the schedule to leave the sync without one.")
+			return nil, diags
+		}
+		schedule.Manual = true
+	}
+
+	result, err := schedule.Map()
+	if err != nil {
+		diags.AddAttributeError(path.Root("schedule"), "Invalid Schedule", err.Error())
+		return nil, diags
+	}
+	return result, diags
+}
+
+// flattenSchedule converts the schedule object returned by the API. A sync
+// without a schedule gives nil.
+func flattenSchedule(schedule map[string]interface{}) (*SyncScheduleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parsed, err := hightouch.ParseSyncSchedule(schedule)
+	if err != nil {
+		diags.AddError("Invalid Sync Schedule", "Could not read the sync schedule returned by Hightouch: "+err.Error())
+		return nil, diags
+	}
+
+	model := &SyncScheduleModel{Manual: types.BoolNull()}
+	switch {
+	case parsed.Interval != nil:
+		model.Interval = &SyncIntervalModel{
+			Quantity: types.Int64Value(int64(parsed.Interval.Quantity)),
+			Unit:     types.StringValue(parsed.Interval.Unit),
+		}
+	case parsed.Cron != "":
+		model.Cron = &SyncCronModel{Expression: types.StringValue(parsed.Cron)}
+	case parsed.Visual != nil:
+		model.Visual = &SyncVisualModel{
+			Days:  stringModels(parsed.Visual.Days),
+			Times: stringModels(parsed.Visual.Times),
+		}
+	case parsed.DBTCloudJobID != "":
+		model.DBTCloud = &SyncDBTCloudModel{JobID: types.StringValue(parsed.DBTCloudJobID)}
+	case parsed.Manual:
+		model.Manual = types.BoolValue(true)
+	default:
+		return nil, diags
+	}
+	return model, diags
+}
+
+// upgradeScheduleV0 upgrades the state of a sync written before version 1 of
+// the schema, which stored the schedule as a JSON string.
+func upgradeScheduleV0(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Sync State", "The prior state of the sync is missing.")
+		return
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Sync State", "Could not parse the prior state of the sync: "+err.Error())
+		return
+	}
+
+	var schedule map[string]interface{}
+	if scheduleJSON, ok := state["schedule"].(string); ok && scheduleJSON != "" {
+		var err error
+		schedule, err = helper.DecodeJSONObject(scheduleJSON)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Sync State", "Could not parse the schedule JSON of the sync: "+err.Error())
+			return
+		}
+	}
+	model, diags := flattenSchedule(schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state["schedule"] = scheduleStateValue(model)
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Sync State", fmt.Sprintf("Could not marshal the upgraded state of the sync: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// scheduleStateValue returns the JSON state representation of a schedule.
+func scheduleStateValue(model *SyncScheduleModel) interface{} {
+	if model == nil {
+		return nil
+	}
+	value := map[string]interface{}{
+		"interval":  nil,
+		"cron":      nil,
+		"visual":    nil,
+		"dbt_cloud": nil,
+		"manual":    nil,
+	}
+	if model.Interval != nil {
+		value["interval"] = map[string]interface{}{
+			"quantity": model.Interval.Quantity.ValueInt64(),
+			"unit":     model.Interval.Unit.ValueString(),
+		}
+	}
+	if model.Cron != nil {
+		value["cron"] = map[string]interface{}{"expression": model.Cron.Expression.ValueString()}
+	}
+	if model.Visual != nil {
+		value["visual"] = map[string]interface{}{
+			"days":  stringValues(model.Visual.Days),
+			"times": stringValues(model.Visual.Times),
+		}
+	}
+	if model.DBTCloud != nil {
+		value["dbt_cloud"] = map[string]interface{}{"job_id": model.DBTCloud.JobID.ValueString()}
+	}
+	if !model.Manual.IsNull() {
+		value["manual"] = model.Manual.ValueBool()
+	}
+	return value
+}
+
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+func stringModels(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
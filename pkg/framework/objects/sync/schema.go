@@ -1,15 +1,45 @@
 package sync
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/framework/jsontype"
 )
 
+// syncMappingAttributes are the attributes of a single field mapping, shared
+// by the identifier attribute and the mapping and custom_mapping blocks.
+var syncMappingAttributes = map[string]schema.Attribute{
+	"from": schema.StringAttribute{
+		Description: "The model column to read the value from.",
+		Required:    true,
+	},
+	"to": schema.StringAttribute{
+		Description: "The destination field to write the value to.",
+		Required:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the mapping: `standard`, `static`, `variable` or `template`. Defaults to `standard`.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("standard", "static", "variable", "template"),
+		},
+	},
+}
+
 var SyncResourceSchema = schema.Schema{
+	// Version 1 replaced the schedule JSON string with a nested attribute.
+	Version:     1,
 	Description: "Represents a Hightouch Sync, which connects a model to a destination and defines how data flows between them.",
 	Attributes: map[string]schema.Attribute{
 		"id": schema.Int64Attribute{
@@ -21,8 +51,11 @@ var SyncResourceSchema = schema.Schema{
 			Required:    true,
 		},
 		"slug": schema.StringAttribute{
-			Description: "The slug of the sync.",
+			Description: "The slug of the sync. Slugs cannot be changed in place, so changing it replaces the sync.",
 			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 		"destination_id": schema.Int64Attribute{
 			Description: "The ID of the destination for this sync.",
@@ -33,17 +66,116 @@ var SyncResourceSchema = schema.Schema{
 			Required:    true,
 		},
 		"source_id": schema.Int64Attribute{
-			Description: "The ID of the source (usually inherited from model).",
+			Description: "The ID of the source the model queries. Defaults to the source of the model; setting it to a different source is an error.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"mode": schema.StringAttribute{
+			Description: "How records are written to the destination: `upsert`, `update`, `insert` or `mirror`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("upsert", "update", "insert", "mirror"),
+			},
+		},
+		"identifier": schema.SingleNestedAttribute{
+			Description: "Matches model rows to existing records in the destination.",
+			Optional:    true,
+			Attributes:  syncMappingAttributes,
+		},
+		"on_delete": schema.StringAttribute{
+			Description: "What happens in the destination when a row leaves the model, for example `clear` or `delete`.",
 			Optional:    true,
 		},
 		"configuration": schema.StringAttribute{
-			Description: "JSON configuration for the sync (field mappings, etc.).",
-			Required:    true,
+			Description: "JSON configuration for settings that have no attribute of their own. It must not set a key managed by `mode`, `identifier`, `mapping`, `custom_mapping` or `on_delete` when that attribute is also set. Differences in key order, whitespace or number formatting, and keys Hightouch adds with a null value, are not shown as changes.",
+			Optional:    true,
+			CustomType:  jsontype.NormalizedType{},
 		},
-		"schedule": schema.StringAttribute{
-			Description: "JSON schedule configuration for the sync.",
+		"schedule": schema.SingleNestedAttribute{
+			Description: "When the sync runs. Set exactly one of `interval`, `cron`, `visual`, `dbt_cloud` or `manual`. Without a schedule the sync only runs when triggered.",
 			Optional:    true,
-			Default:     stringdefault.StaticString("{}"),
+			Attributes: map[string]schema.Attribute{
+				"interval": schema.SingleNestedAttribute{
+					Description: "Runs the sync at a fixed interval.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"quantity": schema.Int64Attribute{
+							Description: "The number of units between runs.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"unit": schema.StringAttribute{
+							Description: "The unit of the interval: `minute`, `hour`, `day` or `week`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("minute", "hour", "day", "week"),
+							},
+						},
+					},
+				},
+				"cron": schema.SingleNestedAttribute{
+					Description: "Runs the sync on a cron schedule.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"expression": schema.StringAttribute{
+							Description: "A five-field cron expression, evaluated in UTC.",
+							Required:    true,
+							Validators: []validator.String{
+								cronExpression{},
+							},
+						},
+					},
+				},
+				"visual": schema.SingleNestedAttribute{
+					Description: "Runs the sync on given days of the week at given times of day.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"days": schema.SetAttribute{
+							Description: "The days of the week, in lower case (`monday` to `sunday`).",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday")),
+							},
+						},
+						"times": schema.ListAttribute{
+							Description: "The times of day, as `HH:MM` in UTC.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day in HH:MM format")),
+							},
+						},
+					},
+				},
+				"dbt_cloud": schema.SingleNestedAttribute{
+					Description: "Runs the sync after a dbt Cloud job completes.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"job_id": schema.StringAttribute{
+							Description: "The ID of the dbt Cloud job.",
+							Required:    true,
+						},
+					},
+				},
+				"manual": schema.BoolAttribute{
+					Description: "Set to true to only run the sync when it is triggered.",
+					Optional:    true,
+				},
+			},
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(
+					path.MatchRelative().AtName("interval"),
+					path.MatchRelative().AtName("cron"),
+					path.MatchRelative().AtName("visual"),
+					path.MatchRelative().AtName("dbt_cloud"),
+					path.MatchRelative().AtName("manual"),
+				),
+			},
 		},
 		"status": schema.StringAttribute{
 			Description: "The current status of the sync.",
@@ -52,6 +184,7 @@ var SyncResourceSchema = schema.Schema{
 		"disabled": schema.BoolAttribute{
 			Description: "Whether the sync is disabled.",
 			Optional:    true,
+			Computed:    true,
 			Default:     booldefault.StaticBool(false),
 		},
 		"workspace_id": schema.Int64Attribute{
@@ -70,21 +203,38 @@ var SyncResourceSchema = schema.Schema{
 			Computed:    true,
 		},
 	},
+	Blocks: map[string]schema.Block{
+		"mapping": schema.ListNestedBlock{
+			Description: "Maps a model column to a standard field of the destination.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: syncMappingAttributes,
+			},
+		},
+		"custom_mapping": schema.ListNestedBlock{
+			Description: "Maps a model column to a custom field of the destination.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: syncMappingAttributes,
+			},
+		},
+	},
 }
 
 var SyncDataSourceSchema = datasourceschema.Schema{
 	Description: "Fetches information about a Hightouch Sync.",
 	Attributes: map[string]datasourceschema.Attribute{
 		"id": datasourceschema.Int64Attribute{
-			Description: "The ID of the sync.",
-			Required:    true,
+			Description: "The ID of the sync. Exactly one of `id`, `slug` or `name` must be set to look the sync up.",
+			Optional:    true,
+			Computed:    true,
 		},
 		"name": datasourceschema.StringAttribute{
-			Description: "The name of the sync.",
+			Description: "The name of the sync. Exactly one of `id`, `slug` or `name` must be set to look the sync up; the lookup fails if several syncs share the name.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"slug": datasourceschema.StringAttribute{
-			Description: "The slug of the sync.",
+			Description: "The slug of the sync. Exactly one of `id`, `slug` or `name` must be set to look the sync up.",
+			Optional:    true,
 			Computed:    true,
 		},
 		"destination_id": datasourceschema.Int64Attribute{
@@ -129,3 +279,81 @@ var SyncDataSourceSchema = datasourceschema.Schema{
 		},
 	},
 }
+
+var SyncsDataSourceSchema = datasourceschema.Schema{
+	Description: "Lists Hightouch Syncs, optionally filtered.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"name_regex": datasourceschema.StringAttribute{
+			Description: "A regular expression that sync names must match.",
+			Optional:    true,
+		},
+		"slug_prefix": datasourceschema.StringAttribute{
+			Description: "A prefix that sync slugs must start with.",
+			Optional:    true,
+		},
+		"model_id": datasourceschema.Int64Attribute{
+			Description: "Only return syncs of this model.",
+			Optional:    true,
+		},
+		"source_id": datasourceschema.Int64Attribute{
+			Description: "Only return syncs of models that query this source.",
+			Optional:    true,
+		},
+		"destination_id": datasourceschema.Int64Attribute{
+			Description: "Only return syncs to this destination.",
+			Optional:    true,
+		},
+		"disabled": datasourceschema.BoolAttribute{
+			Description: "Only return syncs that are disabled (true) or enabled (false).",
+			Optional:    true,
+		},
+		"syncs": datasourceschema.ListNestedAttribute{
+			Description: "The matching syncs, ordered by ID.",
+			Computed:    true,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: map[string]datasourceschema.Attribute{
+					"id": datasourceschema.Int64Attribute{
+						Description: "The ID of the sync.",
+						Computed:    true,
+					},
+					"name": datasourceschema.StringAttribute{
+						Description: "The name of the sync.",
+						Computed:    true,
+					},
+					"slug": datasourceschema.StringAttribute{
+						Description: "The slug of the sync.",
+						Computed:    true,
+					},
+					"destination_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the destination for this sync.",
+						Computed:    true,
+					},
+					"model_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the model this sync uses as a data source.",
+						Computed:    true,
+					},
+					"status": datasourceschema.StringAttribute{
+						Description: "The current status of the sync.",
+						Computed:    true,
+					},
+					"disabled": datasourceschema.BoolAttribute{
+						Description: "Whether the sync is disabled.",
+						Computed:    true,
+					},
+					"workspace_id": datasourceschema.Int64Attribute{
+						Description: "The ID of the workspace that the sync belongs to.",
+						Computed:    true,
+					},
+					"created_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the sync was created.",
+						Computed:    true,
+					},
+					"updated_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the sync was last updated.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,157 @@
+package sync_run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/hightouch"
+	"time"
+)
+
+// defaultListLimit is the number of runs returned when no limit is configured.
+const defaultListLimit = 10
+
+// SyncRunsDataSource is the data source implementation for listing the runs of a sync.
+type SyncRunsDataSource struct {
+	client *hightouch.Client
+}
+
+// NewSyncRunsDataSource is a helper function to simplify data source server allocation.
+func NewSyncRunsDataSource() datasource.DataSource {
+	return &SyncRunsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SyncRunsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sync_runs"
+}
+
+// Schema defines the schema for the data source.
+func (d *SyncRunsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = SyncRunsDataSourceSchema
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SyncRunsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SyncRunsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config SyncRunsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := hightouch.ListSyncRunsOptions{Limit: defaultListLimit}
+	if !config.Limit.IsNull() {
+		opts.Limit = int(config.Limit.ValueInt64())
+	}
+	for _, status := range config.Statuses {
+		opts.Statuses = append(opts.Statuses, status.ValueString())
+	}
+	opts.After = parseTimestamp(config.After, path.Root("after"), resp)
+	opts.Before = parseTimestamp(config.Before, path.Root("before"), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncID := int(config.SyncID.ValueInt64())
+	runs, err := d.client.ListSyncRuns(ctx, syncID, opts)
+	if errors.Is(err, hightouch.ErrStatusFilterLimit) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("statuses"),
+			"Sync Run Search Incomplete",
+			fmt.Sprintf("Only the %d most recent runs of sync %d were searched by status, so older matching runs are missing. Set before to search older runs.", hightouch.MaxStatusFilteredRuns, syncID),
+		)
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing sync runs", "Could not list sync runs, unexpected error: "+err.Error())
+		return
+	}
+
+	failed := 0
+	config.Runs = []SyncRunSummaryModel{}
+	for _, run := range runs {
+		if run.Failed() {
+			failed++
+		}
+		config.Runs = append(config.Runs, SyncRunSummaryModel{
+			ID:              types.Int64Value(int64(run.ID)),
+			Status:          types.StringValue(run.Status),
+			Error:           types.StringPointerValue(run.Error),
+			PlannedRows:     rowsModel(run.PlannedRows),
+			SuccessfulRows:  rowsModel(run.SuccessfulRows),
+			FailedRows:      rowsModel(run.FailedRows),
+			CompletionRatio: types.Float64Value(run.CompletionRatio),
+			CreatedAt:       types.StringValue(run.CreatedAt.String()),
+			StartedAt:       timestampValue(run.StartedAt),
+			FinishedAt:      timestampValue(run.FinishedAt),
+		})
+	}
+	config.FailedCount = types.Int64Value(int64(failed))
+
+	// Set state
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseTimestamp parses an optional RFC 3339 timestamp, returning the zero
+// time if value is null and adding an error to resp if it is invalid.
+func parseTimestamp(value types.String, attribute path.Path, resp *datasource.ReadResponse) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as 2024-01-02T15:04:05Z, got %q.", value.ValueString()),
+		)
+	}
+	return t
+}
+
+// timestampValue returns t as a string, or null if it is not set.
+func timestampValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.String())
+}
@@ -0,0 +1,56 @@
+package sync_run
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyncRunResourceModel maps the resource schema data for a run of a Hightouch sync.
+type SyncRunResourceModel struct {
+	ID                types.Int64       `tfsdk:"id"`
+	SyncID            types.Int64       `tfsdk:"sync_id"`
+	Triggers          types.Map         `tfsdk:"triggers"`
+	FullResync        types.Bool        `tfsdk:"full_resync"`
+	WaitForCompletion types.Bool        `tfsdk:"wait_for_completion"`
+	Status            types.String      `tfsdk:"status"`
+	Error             types.String      `tfsdk:"error"`
+	PlannedRows       *SyncRunRowsModel `tfsdk:"planned_rows"`
+	SuccessfulRows    *SyncRunRowsModel `tfsdk:"successful_rows"`
+	FailedRows        *SyncRunRowsModel `tfsdk:"failed_rows"`
+	CompletionRatio   types.Float64     `tfsdk:"completion_ratio"`
+	CreatedAt         types.String      `tfsdk:"created_at"`
+	FinishedAt        types.String      `tfsdk:"finished_at"`
+	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// SyncRunRowsModel maps the row counts of a sync run.
+type SyncRunRowsModel struct {
+	Added   types.Int64 `tfsdk:"added"`
+	Changed types.Int64 `tfsdk:"changed"`
+	Removed types.Int64 `tfsdk:"removed"`
+}
+
+// SyncRunsDataSourceModel maps the schema data for the hightouch_sync_runs data source.
+type SyncRunsDataSourceModel struct {
+	SyncID      types.Int64           `tfsdk:"sync_id"`
+	Statuses    []types.String        `tfsdk:"statuses"`
+	After       types.String          `tfsdk:"after"`
+	Before      types.String          `tfsdk:"before"`
+	Limit       types.Int64           `tfsdk:"limit"`
+	FailedCount types.Int64           `tfsdk:"failed_count"`
+	Runs        []SyncRunSummaryModel `tfsdk:"runs"`
+}
+
+// SyncRunSummaryModel maps a single run returned by the hightouch_sync_runs data source.
+type SyncRunSummaryModel struct {
+	ID              types.Int64       `tfsdk:"id"`
+	Status          types.String      `tfsdk:"status"`
+	Error           types.String      `tfsdk:"error"`
+	PlannedRows     *SyncRunRowsModel `tfsdk:"planned_rows"`
+	SuccessfulRows  *SyncRunRowsModel `tfsdk:"successful_rows"`
+	FailedRows      *SyncRunRowsModel `tfsdk:"failed_rows"`
+	CompletionRatio types.Float64     `tfsdk:"completion_ratio"`
+	CreatedAt       types.String      `tfsdk:"created_at"`
+	StartedAt       types.String      `tfsdk:"started_at"`
+	FinishedAt      types.String      `tfsdk:"finished_at"`
+}
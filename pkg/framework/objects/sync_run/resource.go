@@ -0,0 +1,224 @@
+package sync_run
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-hightouch/pkg/hightouch"
+	"time"
+)
+
+// defaultCreateTimeout is how long Create waits for a run to finish when no
+// create timeout is configured.
+const defaultCreateTimeout = 20 * time.Minute
+
+// pollInterval is how often a run is read while waiting for it to finish.
+const pollInterval = 10 * time.Second
+
+// SyncRunResource is the resource implementation.
+type SyncRunResource struct {
+	client *hightouch.Client
+}
+
+// NewSyncRunResource is a helper function to simplify resource server allocation.
+func NewSyncRunResource() resource.Resource {
+	return &SyncRunResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SyncRunResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sync_run"
+}
+
+// Schema defines the schema for the resource.
+func (r *SyncRunResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = SyncRunResourceSchema
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+	}
+}
+
+// Configure adds the hightouch configured client to the resource.
+func (r *SyncRunResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*hightouch.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create triggers a run of the sync and, if requested, waits for it to finish.
+func (r *SyncRunResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Retrieve values from plan
+	var plan SyncRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the API to trigger the run
+	syncID := int(plan.SyncID.ValueInt64())
+	runID, err := r.client.TriggerSync(ctx, syncID, plan.FullResync.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error triggering sync run", "Could not trigger sync, unexpected error: "+err.Error())
+		return
+	}
+	plan.ID = types.Int64Value(int64(runID))
+	tflog.Info(ctx, "Triggered sync run", map[string]interface{}{
+		"sync_id":     syncID,
+		"run_id":      runID,
+		"full_resync": plan.FullResync.ValueBool(),
+	})
+
+	var run *hightouch.SyncRun
+	if plan.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+		run, err = r.client.WaitForSyncRun(waitCtx, syncID, runID, pollInterval)
+	} else {
+		run, err = r.client.GetSyncRun(ctx, syncID, runID)
+	}
+	if run == nil {
+		// Keep the triggered run in state even if it could not be read, so
+		// the next apply does not trigger another one.
+		run = &hightouch.SyncRun{ID: runID, Status: "unknown"}
+	}
+
+	// Set state to fully populated data
+	setRun(&plan, run)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for sync run", fmt.Sprintf("Could not read run %d of sync %d: %s", runID, syncID, err))
+		return
+	}
+	if plan.WaitForCompletion.ValueBool() && !run.Succeeded() {
+		// Returning an error taints the resource, so the next apply runs the
+		// sync again.
+		resp.Diagnostics.AddError(
+			"Sync Run Failed",
+			fmt.Sprintf("Run %d of sync %d finished with status %q: %s", runID, syncID, run.Status, plan.Error.ValueString()),
+		)
+	}
+}
+
+// Read refreshes the resource state with the latest status of the run.
+func (r *SyncRunResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SyncRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncID := int(state.SyncID.ValueInt64())
+	runID := int(state.ID.ValueInt64())
+	run, err := r.client.GetSyncRun(ctx, syncID, runID)
+	if hightouch.IsNotFound(err) {
+		// The sync, and with it the run, was deleted; drop the run from state
+		// so Terraform plans to trigger a new one.
+		tflog.Warn(ctx, "Sync run no longer exists in Hightouch, removing it from state", map[string]interface{}{
+			"sync_id": syncID,
+			"run_id":  runID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading sync run", "Could not read sync run, unexpected error: "+err.Error())
+		return
+	}
+
+	setRun(&state, run)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only changes wait_for_completion and timeouts, which do not affect
+// the run; every other change triggers a new run.
+func (r *SyncRunResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state SyncRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the run from state. Runs cannot be deleted in Hightouch.
+func (r *SyncRunResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+// setRun copies the status of a run to model.
+func setRun(model *SyncRunResourceModel, run *hightouch.SyncRun) {
+	model.ID = types.Int64Value(int64(run.ID))
+	model.Status = types.StringValue(run.Status)
+	model.Error = types.StringNull()
+	if run.Error != nil {
+		model.Error = types.StringValue(*run.Error)
+	}
+	model.PlannedRows = rowsModel(run.PlannedRows)
+	model.SuccessfulRows = rowsModel(run.SuccessfulRows)
+	model.FailedRows = rowsModel(run.FailedRows)
+	model.CompletionRatio = types.Float64Value(run.CompletionRatio)
+	model.CreatedAt = types.StringValue(run.CreatedAt.String())
+	model.FinishedAt = timestampValue(run.FinishedAt)
+}
+
+func rowsModel(rows hightouch.SyncRunRows) *SyncRunRowsModel {
+	return &SyncRunRowsModel{
+		Added:   types.Int64Value(int64(rows.AddedCount)),
+		Changed: types.Int64Value(int64(rows.ChangedCount)),
+		Removed: types.Int64Value(int64(rows.RemovedCount)),
+	}
+}
@@ -0,0 +1,188 @@
+package sync_run_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-hightouch/pkg/acctest"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func TestAccSyncRunResource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncRunConfig("v1", true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hightouch_sync_run.test", "id"),
+					resource.TestCheckResourceAttr("hightouch_sync_run.test", "status", "success"),
+					resource.TestCheckResourceAttr("hightouch_sync_run.test", "successful_rows.added", "10"),
+					resource.TestCheckResourceAttr("hightouch_sync_run.test", "completion_ratio", "1"),
+					resource.TestCheckResourceAttrSet("hightouch_sync_run.test", "finished_at"),
+					testAccCheckSyncRuns(server, 1, true),
+				),
+			},
+			{
+				// Changing a trigger runs the sync again.
+				Config: acctest.ProviderConfig(server) + testAccSyncRunConfig("v2", false, true),
+				Check:  testAccCheckSyncRuns(server, 2, false),
+			},
+			{
+				// Not waiting does not change the run.
+				Config: acctest.ProviderConfig(server) + testAccSyncRunConfig("v2", false, false),
+				Check:  testAccCheckSyncRuns(server, 2, false),
+			},
+		},
+	})
+}
+
+func TestAccSyncRunResource_failed(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+	server.SetSyncRunOutcome(0, "failed", "destination rejected the batch")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig(server) + testAccSyncRunConfig("v1", false, true),
+				ExpectError: regexp.MustCompile(`(?s)Sync Run Failed.*destination rejected the batch`),
+			},
+		},
+	})
+}
+
+func TestAccSyncRunsDataSource(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+	server.SetSyncRunOutcome(0, "failed", "destination rejected the batch")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   acctest.WriteOnlyVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncRunConfig("v1", false, false) + `
+data "hightouch_sync_runs" "recent" {
+  sync_id = hightouch_sync_run.test.sync_id
+  after   = "2000-01-01T00:00:00Z"
+}
+
+data "hightouch_sync_runs" "successful" {
+  sync_id  = hightouch_sync_run.test.sync_id
+  statuses = ["success", "warning"]
+}
+
+data "hightouch_sync_runs" "old" {
+  sync_id = hightouch_sync_run.test.sync_id
+  before  = "2000-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.recent", "runs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.hightouch_sync_runs.recent", "runs.0.id", "hightouch_sync_run.test", "id"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.recent", "runs.0.status", "failed"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.recent", "runs.0.error", "destination rejected the batch"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.recent", "runs.0.planned_rows.added", "10"),
+					resource.TestCheckResourceAttrSet("data.hightouch_sync_runs.recent", "runs.0.started_at"),
+					resource.TestCheckResourceAttrSet("data.hightouch_sync_runs.recent", "runs.0.finished_at"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.recent", "failed_count", "1"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.successful", "runs.#", "0"),
+					resource.TestCheckResourceAttr("data.hightouch_sync_runs.old", "runs.#", "0"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig(server) + testAccSyncRunConfig("v1", false, false) + `
+data "hightouch_sync_runs" "invalid" {
+  sync_id = hightouch_sync_run.test.sync_id
+  after   = "yesterday"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+		},
+	})
+}
+
+// testAccCheckSyncRuns checks the number of runs of the sync and whether the
+// latest one was a full resync.
+func testAccCheckSyncRuns(server *hightouchtest.Server, count int, fullResync bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		syncID, err := strconv.Atoi(s.RootModule().Resources["hightouch_sync.test"].Primary.ID)
+		if err != nil {
+			return err
+		}
+		runs := server.SyncRuns(syncID)
+		if len(runs) != count {
+			return fmt.Errorf("sync has %d runs, want %d", len(runs), count)
+		}
+		if latest := runs[len(runs)-1]; latest.FullResync != fullResync {
+			return fmt.Errorf("latest run full resync = %t, want %t", latest.FullResync, fullResync)
+		}
+		return nil
+	}
+}
+
+func testAccSyncRunConfig(version string, fullResync, wait bool) string {
+	return fmt.Sprintf(`
+resource "hightouch_snowflake_source" "test" {
+  name      = "Warehouse"
+  slug      = "acc-run-warehouse"
+  account   = "acme"
+  port      = 443
+  username  = "loader"
+  password  = "hunter2"
+  database  = "ANALYTICS"
+  warehouse = "COMPUTE_WH"
+}
+
+resource "hightouch_model" "test" {
+  name        = "Users"
+  slug        = "acc-run-users"
+  source_id   = hightouch_snowflake_source.test.id
+  sql         = "select id, email from users"
+  primary_key = "id"
+}
+
+resource "hightouch_iterable_destination" "test" {
+  name    = "Iterable"
+  slug    = "acc-run-iterable"
+  api_key = "iterable-secret"
+}
+
+resource "hightouch_sync" "test" {
+  name           = "Users to Iterable"
+  slug           = "acc-run-users-to-iterable"
+  model_id       = hightouch_model.test.id
+  destination_id = hightouch_iterable_destination.test.id
+  mode           = "upsert"
+
+  mapping {
+    from = "email"
+    to   = "email"
+  }
+}
+
+resource "hightouch_sync_run" "test" {
+  sync_id             = hightouch_sync.test.id
+  full_resync         = %t
+  wait_for_completion = %t
+
+  triggers = {
+    version = %q
+  }
+
+  timeouts {
+    create = "5m"
+  }
+}
+`, fullResync, wait, version)
+}
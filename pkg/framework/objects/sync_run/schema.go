@@ -0,0 +1,223 @@
+package sync_run
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// rowsAttribute describes the row counts of a run.
+func rowsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"added": schema.Int64Attribute{
+				Description: "The number of rows added.",
+				Computed:    true,
+			},
+			"changed": schema.Int64Attribute{
+				Description: "The number of rows changed.",
+				Computed:    true,
+			},
+			"removed": schema.Int64Attribute{
+				Description: "The number of rows removed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// SyncRunResourceSchema is the schema of hightouch_sync_run, without the
+// timeouts block, which is added by the resource.
+var SyncRunResourceSchema = schema.Schema{
+	Description: "Triggers a run of a Hightouch Sync when it is created, and again whenever `triggers` change. Destroying it does not affect the sync or its runs.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the run.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"sync_id": schema.Int64Attribute{
+			Description: "The ID of the sync to run. Changing it triggers a new run.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Description: "Arbitrary values that trigger a new run when they change, for example the SQL of the model.",
+			Optional:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"full_resync": schema.BoolAttribute{
+			Description: "Whether to sync every row of the model again rather than only the changes. Defaults to false. Changing it triggers a new run.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			Description: "Whether to wait for the run to finish, failing the apply if the run fails. Defaults to true. The time to wait is set by `timeouts.create`, 20 minutes by default.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the run, such as `processing`, `success`, `warning` or `failed`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"error": schema.StringAttribute{
+			Description: "The error message of a failed run.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"planned_rows":    rowsAttribute("The number of rows the run planned to sync."),
+		"successful_rows": rowsAttribute("The number of rows the run synced successfully."),
+		"failed_rows":     rowsAttribute("The number of rows the run failed to sync."),
+		"completion_ratio": schema.Float64Attribute{
+			Description: "The share of planned rows processed so far, from 0 to 1.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The timestamp when the run was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"finished_at": schema.StringAttribute{
+			Description: "The timestamp when the run finished, if it has.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
+
+// rowsDataSourceAttribute describes the row counts of a run listed by the
+// hightouch_sync_runs data source.
+func rowsDataSourceAttribute(description string) datasourceschema.SingleNestedAttribute {
+	return datasourceschema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]datasourceschema.Attribute{
+			"added": datasourceschema.Int64Attribute{
+				Description: "The number of rows added.",
+				Computed:    true,
+			},
+			"changed": datasourceschema.Int64Attribute{
+				Description: "The number of rows changed.",
+				Computed:    true,
+			},
+			"removed": datasourceschema.Int64Attribute{
+				Description: "The number of rows removed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var SyncRunsDataSourceSchema = datasourceschema.Schema{
+	Description: "Lists the most recent runs of a Hightouch Sync, optionally filtered by status and creation time. Filtering by status searches a bounded number of runs, see `statuses`. Use it in a `check` block or a `precondition` to stop an apply when the last run failed.",
+	Attributes: map[string]datasourceschema.Attribute{
+		"sync_id": datasourceschema.Int64Attribute{
+			Description: "The ID of the sync.",
+			Required:    true,
+		},
+		"statuses": datasourceschema.SetAttribute{
+			Description: fmt.Sprintf("Only return runs with one of these statuses, such as `success`, `warning` or `failed`. Hightouch cannot filter runs by status, so only the %d most recent runs that match `after` and `before` are searched, with a warning if older runs remain; set `before` to search older runs.", hightouch.MaxStatusFilteredRuns),
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"after": datasourceschema.StringAttribute{
+			Description: "Only return runs created at or after this RFC 3339 timestamp, for example `timeadd(timestamp(), \"-24h\")`.",
+			Optional:    true,
+		},
+		"before": datasourceschema.StringAttribute{
+			Description: "Only return runs created before this RFC 3339 timestamp.",
+			Optional:    true,
+		},
+		"limit": datasourceschema.Int64Attribute{
+			Description: "The maximum number of runs to return. Defaults to 10.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"failed_count": datasourceschema.Int64Attribute{
+			Description: "The number of returned runs that finished without succeeding, with the status `failed`, `cancelled`, `interrupted` or `abandoned`.",
+			Computed:    true,
+		},
+		"runs": datasourceschema.ListNestedAttribute{
+			Description: "The matching runs, most recent first.",
+			Computed:    true,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: map[string]datasourceschema.Attribute{
+					"id": datasourceschema.Int64Attribute{
+						Description: "The ID of the run.",
+						Computed:    true,
+					},
+					"status": datasourceschema.StringAttribute{
+						Description: "The status of the run, such as `processing`, `success`, `warning` or `failed`.",
+						Computed:    true,
+					},
+					"error": datasourceschema.StringAttribute{
+						Description: "The error message of a failed run.",
+						Computed:    true,
+					},
+					"planned_rows":    rowsDataSourceAttribute("The number of rows the run planned to sync."),
+					"successful_rows": rowsDataSourceAttribute("The number of rows the run synced successfully."),
+					"failed_rows":     rowsDataSourceAttribute("The number of rows the run failed to sync."),
+					"completion_ratio": datasourceschema.Float64Attribute{
+						Description: "The share of planned rows processed, from 0 to 1.",
+						Computed:    true,
+					},
+					"created_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the run was created.",
+						Computed:    true,
+					},
+					"started_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the run started, if it has.",
+						Computed:    true,
+					},
+					"finished_at": datasourceschema.StringAttribute{
+						Description: "The timestamp when the run finished, if it has.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
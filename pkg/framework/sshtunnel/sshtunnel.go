@@ -0,0 +1,190 @@
+// Package sshtunnel provides the ssh_tunnel attribute shared by the source
+// resources that connect to a database server, for databases in private
+// networks that Hightouch reaches through a bastion host.
+package sshtunnel
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// defaultPort is the port of the bastion host when none is configured.
+const defaultPort = 22
+
+// Model maps the ssh_tunnel attribute of a source resource.
+type Model struct {
+	Host                types.String `tfsdk:"host"`
+	Port                types.Int64  `tfsdk:"port"`
+	User                types.String `tfsdk:"user"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+}
+
+// DataSourceModel maps the ssh_tunnel attribute of a source data source. It
+// has no private key, which is never read back.
+type DataSourceModel struct {
+	Host types.String `tfsdk:"host"`
+	Port types.Int64  `tfsdk:"port"`
+	User types.String `tfsdk:"user"`
+}
+
+// ResourceAttribute is the schema of the ssh_tunnel attribute of a source
+// resource.
+var ResourceAttribute = schema.SingleNestedAttribute{
+	Description: "Connect to the database through an SSH tunnel to a bastion host. Hightouch authenticates to the bastion with a private key whose public key is authorized for user.",
+	Optional:    true,
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Description: "Hostname or IP address of the bastion host.",
+			Required:    true,
+		},
+		"port": schema.Int64Attribute{
+			Description: "SSH port of the bastion host. Defaults to 22.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(defaultPort),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"user": schema.StringAttribute{
+			Description: "User to log in to the bastion host as.",
+			Required:    true,
+		},
+		"private_key": schema.StringAttribute{
+			Description: "PEM-encoded private key used to log in to the bastion host. Write-only: it is never stored in state, so change private_key_wo_version to send a new key. Requires Terraform 1.11 or later.",
+			Required:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				privateKeyValidator{},
+			},
+		},
+		"private_key_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send the current private_key to Hightouch, for example after rotating it.",
+			Optional:    true,
+		},
+	},
+}
+
+// DataSourceAttribute is the schema of the ssh_tunnel attribute of a source
+// data source.
+var DataSourceAttribute = datasourceschema.SingleNestedAttribute{
+	Description: "The SSH tunnel used to connect to the database, if any.",
+	Computed:    true,
+	Attributes: map[string]datasourceschema.Attribute{
+		"host": datasourceschema.StringAttribute{
+			Description: "Hostname or IP address of the bastion host.",
+			Computed:    true,
+		},
+		"port": datasourceschema.Int64Attribute{
+			Description: "SSH port of the bastion host.",
+			Computed:    true,
+		},
+		"user": datasourceschema.StringAttribute{
+			Description: "User to log in to the bastion host as.",
+			Computed:    true,
+		},
+	},
+}
+
+// Configuration returns the value of the ssh_tunnel configuration key for
+// tunnel, with the write-only private key read from config. It returns nil,
+// which removes the tunnel, when tunnel is nil.
+func Configuration(ctx context.Context, config tfsdk.Config, tunnel *Model) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if tunnel == nil {
+		return nil, diags
+	}
+
+	var privateKey types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("ssh_tunnel").AtName("private_key"), &privateKey)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return map[string]interface{}{
+		"host":        tunnel.Host.ValueString(),
+		"port":        tunnel.Port.ValueInt64(),
+		"user":        tunnel.User.ValueString(),
+		"private_key": privateKey.ValueString(),
+	}, diags
+}
+
+// Changed reports whether an update must send the tunnel in plan. The API
+// replaces the whole ssh_tunnel object, so the tunnel, private key included,
+// is sent whenever any of its attributes or private_key_wo_version changes.
+func Changed(plan, state *Model) bool {
+	if plan == nil || state == nil {
+		return plan != state
+	}
+	return !plan.Host.Equal(state.Host) ||
+		!plan.Port.Equal(state.Port) ||
+		!plan.User.Equal(state.User) ||
+		!plan.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion)
+}
+
+// FromConfiguration returns the state of the tunnel returned by the API,
+// keeping private_key_wo_version from prior, the tunnel in the prior state.
+func FromConfiguration(tunnel *hightouch.SSHTunnelConfiguration, prior *Model) *Model {
+	if tunnel == nil {
+		return nil
+	}
+	model := &Model{
+		Host:                types.StringValue(tunnel.Host),
+		Port:                types.Int64Value(defaultPort),
+		User:                types.StringValue(tunnel.User),
+		PrivateKey:          types.StringNull(),
+		PrivateKeyWOVersion: types.Int64Null(),
+	}
+	if tunnel.Port != nil {
+		model.Port = types.Int64Value(*tunnel.Port)
+	}
+	if prior != nil {
+		model.PrivateKeyWOVersion = prior.PrivateKeyWOVersion
+	}
+	return model
+}
+
+// DataSourceFromConfiguration returns the data source state of the tunnel
+// returned by the API.
+func DataSourceFromConfiguration(tunnel *hightouch.SSHTunnelConfiguration) *DataSourceModel {
+	model := FromConfiguration(tunnel, nil)
+	if model == nil {
+		return nil
+	}
+	return &DataSourceModel{Host: model.Host, Port: model.Port, User: model.User}
+}
+
+// privateKeyValidator validates that a string is a PEM-encoded private key.
+type privateKeyValidator struct{}
+
+func (v privateKeyValidator) Description(_ context.Context) string {
+	return "value must be a PEM-encoded private key"
+}
+
+func (v privateKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v privateKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if block, _ := pem.Decode([]byte(req.ConfigValue.ValueString())); block == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Private Key",
+			"The SSH tunnel private key must be a PEM-encoded private key, such as the contents of an id_ed25519 or id_rsa file.",
+		)
+	}
+}
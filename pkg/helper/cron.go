@@ -0,0 +1,88 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField describes one field of a five-field cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// ValidateCron checks that expression is a standard five-field cron
+// expression: minute, hour, day of month, month and day of week. Each field
+// is a comma-separated list of "*", values or ranges, optionally followed by
+// "/<step>". Months and days of the week may also be given by their
+// three-letter English names.
+func ValidateCron(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("cron expression %q must have 5 fields (minute, hour, day of month, month, day of week), got %d", expression, len(fields))
+	}
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := cronFields[i].validate(item); err != nil {
+				return fmt.Errorf("cron expression %q has an invalid %s field: %w", expression, cronFields[i].name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(item string) error {
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+	if base == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(base, "-")
+	start, err := f.value(low)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	end, err := f.value(high)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range %q starts after it ends", base)
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,34 @@
+package helper
+
+import "testing"
+
+func TestValidateCron(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    bool
+	}{
+		{expression: "* * * * *"},
+		{expression: "0 6 * * 1-5"},
+		{expression: "*/15 0-23/2 1,15 jan-jun MON-FRI"},
+		{expression: "30 4 1 * sun"},
+		{expression: "0 0 * * 7"},
+		{expression: "", wantErr: true},
+		{expression: "0 6 * *", wantErr: true},
+		{expression: "0 6 * * * *", wantErr: true},
+		{expression: "60 * * * *", wantErr: true},
+		{expression: "0 24 * * *", wantErr: true},
+		{expression: "0 0 0 * *", wantErr: true},
+		{expression: "0 0 * 13 *", wantErr: true},
+		{expression: "*/0 * * * *", wantErr: true},
+		{expression: "5-1 * * * *", wantErr: true},
+		{expression: "@daily * * * *", wantErr: true},
+		{expression: "0 0 * * mon-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateCron(tt.expression)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateCron(%q) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NameFilter matches objects by an optional name regular expression and an
+// optional slug prefix, as used by the list data sources.
+type NameFilter struct {
+	nameRegex  *regexp.Regexp
+	slugPrefix string
+}
+
+// NewNameFilter compiles a NameFilter. Empty arguments match everything.
+func NewNameFilter(nameRegex string, slugPrefix string) (*NameFilter, error) {
+	filter := &NameFilter{slugPrefix: slugPrefix}
+	if nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name regular expression %q: %w", nameRegex, err)
+		}
+		filter.nameRegex = re
+	}
+	return filter, nil
+}
+
+// Match reports whether an object with the given name and slug passes the filter.
+func (f *NameFilter) Match(name string, slug string) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	return strings.HasPrefix(slug, f.slugPrefix)
+}
@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// slugImportPrefix marks an import ID as a slug rather than a numeric ID.
+const slugImportPrefix = "slug:"
+
+// ImportKey identifies the object to import, either by numeric ID or by slug.
+// WorkspaceID is only set when the import ID was a "<workspace_id>/<slug>"
+// composite.
+type ImportKey struct {
+	ID          int
+	Slug        string
+	WorkspaceID int
+}
+
+// ParseImportID parses the ID given to terraform import. It accepts a numeric
+// object ID, "slug:<slug>" or "<workspace_id>/<slug>".
+func ParseImportID(id string) (ImportKey, error) {
+	if slug, ok := strings.CutPrefix(id, slugImportPrefix); ok {
+		if slug == "" {
+			return ImportKey{}, fmt.Errorf("import ID %q is missing a slug", id)
+		}
+		return ImportKey{Slug: slug}, nil
+	}
+
+	if workspace, slug, ok := strings.Cut(id, "/"); ok {
+		workspaceID, err := strconv.Atoi(workspace)
+		if err != nil || workspaceID <= 0 || slug == "" {
+			return ImportKey{}, fmt.Errorf("import ID %q must have the form <workspace_id>/<slug>", id)
+		}
+		return ImportKey{Slug: slug, WorkspaceID: workspaceID}, nil
+	}
+
+	objectID, err := strconv.Atoi(id)
+	if err != nil || objectID <= 0 {
+		return ImportKey{}, fmt.Errorf("import ID %q must be a numeric ID, slug:<slug> or <workspace_id>/<slug>", id)
+	}
+	return ImportKey{ID: objectID}, nil
+}
+
+// CheckWorkspace returns an error if the key names a workspace other than
+// workspaceID, the workspace the resolved object belongs to.
+func (k ImportKey) CheckWorkspace(workspaceID int) error {
+	if k.WorkspaceID != 0 && k.WorkspaceID != workspaceID {
+		return fmt.Errorf("%q belongs to workspace %d, not workspace %d; check that the provider's API key is for the intended workspace",
+			k.Slug, workspaceID, k.WorkspaceID)
+	}
+	return nil
+}
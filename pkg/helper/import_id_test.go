@@ -0,0 +1,43 @@
+package helper
+
+import "testing"
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    ImportKey
+		wantErr bool
+	}{
+		{id: "42", want: ImportKey{ID: 42}},
+		{id: "slug:users-to-iterable", want: ImportKey{Slug: "users-to-iterable"}},
+		{id: "7/users-to-iterable", want: ImportKey{Slug: "users-to-iterable", WorkspaceID: 7}},
+		{id: "slug:", wantErr: true},
+		{id: "7/", wantErr: true},
+		{id: "prod/users", wantErr: true},
+		{id: "users", wantErr: true},
+		{id: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseImportID(tt.id)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseImportID(%q) = %+v, want %+v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestImportKeyCheckWorkspace(t *testing.T) {
+	if err := (ImportKey{Slug: "users"}).CheckWorkspace(3); err != nil {
+		t.Errorf("CheckWorkspace() without a workspace error = %v, want nil", err)
+	}
+	if err := (ImportKey{Slug: "users", WorkspaceID: 3}).CheckWorkspace(3); err != nil {
+		t.Errorf("CheckWorkspace() with the matching workspace error = %v, want nil", err)
+	}
+	if err := (ImportKey{Slug: "users", WorkspaceID: 3}).CheckWorkspace(4); err == nil {
+		t.Error("CheckWorkspace() with another workspace error = nil, want an error")
+	}
+}
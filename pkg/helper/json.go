@@ -0,0 +1,68 @@
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// DecodeJSONObject parses value as a JSON object. An empty value decodes to
+// an empty object.
+func DecodeJSONObject(value string) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	if value == "" {
+		return object, nil
+	}
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		return nil, fmt.Errorf("value must be a JSON object: %w", err)
+	}
+	if object == nil {
+		return nil, fmt.Errorf("value must be a JSON object, got null")
+	}
+	return object, nil
+}
+
+// ProjectKeys returns the entries of actual whose keys also appear in
+// reference. Resources use it to drop keys the API adds to an object, such as
+// server-side defaults, that were never set in the configuration and would
+// otherwise show up as drift. A nil reference returns actual unchanged.
+func ProjectKeys(actual, reference map[string]interface{}) map[string]interface{} {
+	if reference == nil {
+		return actual
+	}
+
+	projected := make(map[string]interface{}, len(reference))
+	for key := range reference {
+		if value, ok := actual[key]; ok {
+			projected[key] = value
+		}
+	}
+	return projected
+}
+
+// JSONEqual reports whether two JSON documents are semantically equal,
+// ignoring key order, whitespace and how numbers are written. When both are
+// objects, top-level keys of current that are missing from prior are ignored
+// if their value is null, so keys the API fills in with null do not count as
+// a change.
+func JSONEqual(prior, current string) (bool, error) {
+	var priorValue, currentValue interface{}
+	if err := json.Unmarshal([]byte(prior), &priorValue); err != nil {
+		return false, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if err := json.Unmarshal([]byte(current), &currentValue); err != nil {
+		return false, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	priorObject, priorIsObject := priorValue.(map[string]interface{})
+	currentObject, currentIsObject := currentValue.(map[string]interface{})
+	if priorIsObject && currentIsObject {
+		for key, value := range currentObject {
+			if _, ok := priorObject[key]; !ok && value == nil {
+				delete(currentObject, key)
+			}
+		}
+	}
+
+	return reflect.DeepEqual(priorValue, currentValue), nil
+}
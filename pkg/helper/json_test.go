@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeJSONObject(t *testing.T) {
+	got, err := DecodeJSONObject(`{"host": "db.internal", "port": 5432}`)
+	if err != nil {
+		t.Fatalf("DecodeJSONObject() error = %v", err)
+	}
+	if want := map[string]interface{}{"host": "db.internal", "port": float64(5432)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeJSONObject() = %v, want %v", got, want)
+	}
+
+	if got, err := DecodeJSONObject(""); err != nil || len(got) != 0 {
+		t.Errorf(`DecodeJSONObject("") = %v, %v, want an empty object`, got, err)
+	}
+
+	for _, value := range []string{`[1, 2]`, `"host"`, `null`, `{`} {
+		if _, err := DecodeJSONObject(value); err == nil {
+			t.Errorf("DecodeJSONObject(%s) error = nil, want an error", value)
+		}
+	}
+}
+
+func TestProjectKeys(t *testing.T) {
+	actual := map[string]interface{}{"host": "db.internal", "port": float64(5432), "sslMode": "require"}
+
+	got := ProjectKeys(actual, map[string]interface{}{"host": "", "port": 0, "removed": true})
+	if want := map[string]interface{}{"host": "db.internal", "port": float64(5432)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ProjectKeys() = %v, want %v", got, want)
+	}
+
+	if got := ProjectKeys(actual, nil); !reflect.DeepEqual(got, actual) {
+		t.Errorf("ProjectKeys(nil reference) = %v, want %v", got, actual)
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	tests := []struct {
+		name           string
+		prior, current string
+		want           bool
+	}{
+		{name: "key order and whitespace", prior: "{\n  \"b\": 1,\n  \"a\": [true, null]\n}", current: `{"a":[true,null],"b":1}`, want: true},
+		{name: "number representation", prior: `{"size": 1.0, "ratio": 2e-1}`, current: `{"size": 1, "ratio": 0.2}`, want: true},
+		{name: "injected null", prior: `{"a": 1}`, current: `{"a": 1, "b": null}`, want: true},
+		{name: "unknown injected key", prior: `{"a": 1}`, current: `{"a": 1, "c": 2}`, want: false},
+		{name: "configured null changed", prior: `{"a": null}`, current: `{"a": 1}`, want: false},
+		{name: "removed key", prior: `{"a": 1, "b": 2}`, current: `{"a": 1}`, want: false},
+		{name: "nested nulls are compared", prior: `{"a": {}}`, current: `{"a": {"b": null}}`, want: false},
+		{name: "different values", prior: `{"a": "1"}`, current: `{"a": 1}`, want: false},
+		{name: "arrays keep their order", prior: `[1, 2]`, current: `[2, 1]`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JSONEqual(tt.prior, tt.current)
+			if err != nil {
+				t.Fatalf("JSONEqual() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("JSONEqual(%s, %s) = %v, want %v", tt.prior, tt.current, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := JSONEqual(`{"a": 1}`, `{`); err == nil {
+		t.Error("JSONEqual() error = nil, want an error for invalid JSON")
+	}
+}
@@ -2,50 +2,154 @@ package hightouch
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"terraform-provider-hightouch/pkg/helper"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// LogSubsystem is the tflog subsystem used for Hightouch API traffic. Its
+// level can be set independently with TF_LOG_PROVIDER_HIGHTOUCH_HTTP.
+const LogSubsystem = "hightouch_http"
+
+// DefaultRequestTimeout bounds a single API call when the caller's context
+// does not already carry a deadline.
+const DefaultRequestTimeout = 15 * time.Second
+
 // Client is a client for the Hightouch API.
 type Client struct {
-	apiKey     string
-	httpClient *http.Client
-	baseURL    string
+	apiKey         string
+	httpClient     *http.Client
+	baseURL        string
+	requestTimeout time.Duration
+	retryPolicy    RetryPolicy
+	limiter        *limiter
+	redactor       *redactor
+}
+
+// ClientOption customises a Client created by NewClient.
+type ClientOption func(*Client)
+
+// WithRetryPolicy overrides the default retry behaviour of the client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithSensitiveKeys adds payload keys whose values must be masked when
+// requests and responses are logged, in addition to the common credential
+// keys such as password, api_key, token and Authorization.
+func WithSensitiveKeys(keys ...string) ClientOption {
+	return func(c *Client) {
+		c.redactor.add(keys...)
+	}
 }
 
-// APIError represents an error response from the Hightouch API.
-type APIError struct {
-	Message string      `json:"message"`
-	Details interface{} `json:"details,omitempty"`
+// WithoutSensitiveKeys returns a copy of configuration without the keys the
+// client masks in its logs, at any depth. Resources use it to keep credentials
+// returned by the API out of Terraform state.
+func (c *Client) WithoutSensitiveKeys(configuration map[string]interface{}) map[string]interface{} {
+	if configuration == nil {
+		return nil
+	}
+	return c.redactor.without(configuration).(map[string]interface{})
 }
 
-func (e APIError) Error() string {
-	return fmt.Sprintf("Hightouch API Error: %s", e.Message)
+// response is the raw result of a single HTTP exchange with the API.
+type response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
 }
 
 // makeRequest is a helper function to create, send, and handle API requests.
-func (c *Client) makeRequest(method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
-	fmt.Print(body)
+// The request is bound to ctx, so cancelling ctx or reaching its deadline aborts
+// the call. Throttled and transiently failing requests are retried according
+// to the client's RetryPolicy, and every attempt waits for the client's rate
+// and concurrency limits.
+//
+// Requests are logged to the LogSubsystem at DEBUG; bodies are only logged at
+// TRACE, with sensitive values redacted.
+func (c *Client) makeRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem)
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, c.apiKey)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "http_method", method)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "http_path", path)
+
+	var payload []byte
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBytes)
+		payload = jsonBytes
 
-		// Peek into the request body for debugging purposes
-		peek, err := helper.PrettyPrintJson(string(jsonBytes))
-		fmt.Println(peek)
+		tflog.SubsystemTrace(ctx, LogSubsystem, "Hightouch API request body", map[string]interface{}{
+			"http_request_body": c.redactor.JSON(payload),
+		})
 	}
 
 	url := fmt.Sprintf("%s%s", c.baseURL, path)
-	fmt.Printf("%s: %s\n", method, url)
-	req, err := http.NewRequest(method, url, reqBody)
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending Hightouch API request")
+
+	start := time.Now()
+	resp, err := c.retryPolicy.do(ctx, method, func() (*response, error) {
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return c.send(ctx, method, url, payload)
+	})
+	if err != nil {
+		// Surface cancellation and deadline errors directly so callers can
+		// recognise them with errors.Is.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("request %s %s aborted: %w", method, path, ctxErr)
+		}
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Hightouch API request failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received Hightouch API response", map[string]interface{}{
+		"http_status": resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Hightouch API response body", map[string]interface{}{
+		"http_response_headers": c.redactor.Header(resp.Header),
+		"http_response_body":    c.redactor.JSON(resp.Body),
+	})
+
+	// Check for non-successful status codes
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newAPIError(resp)
+	}
+
+	return resp.Body, nil
+}
+
+// send performs a single HTTP exchange. If ctx has no deadline, the client's
+// request timeout is applied to the attempt.
+func (c *Client) send(ctx context.Context, method, url string, payload []byte) (*response, error) {
+	if _, ok := ctx.Deadline(); !ok && c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -55,6 +159,10 @@ func (c *Client) makeRequest(method, path string, body interface{}) ([]byte, err
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "hightouch_client-go-client/1.0")
 
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Hightouch API request headers", map[string]interface{}{
+		"http_request_headers": c.redactor.Header(req.Header),
+	})
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
@@ -62,7 +170,9 @@ func (c *Client) makeRequest(method, path string, body interface{}) ([]byte, err
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
-			fmt.Printf("Warning: failed to close response body: %v\n", err)
+			tflog.SubsystemWarn(ctx, LogSubsystem, "Failed to close response body", map[string]interface{}{
+				"error": err.Error(),
+			})
 		}
 	}(resp.Body)
 
@@ -70,29 +180,28 @@ func (c *Client) makeRequest(method, path string, body interface{}) ([]byte, err
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
-	peek, err := helper.PrettyPrintJson(string(respBody))
-	fmt.Println(peek)
 
-	// Check for non-successful status codes
-	fmt.Printf("Response: %s\n", string(respBody))
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var apiErr APIError
-		if err := json.Unmarshal(respBody, &apiErr); err != nil {
-			// If we can't parse the error, return a generic one
-			return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
-		}
-		return nil, apiErr
-	}
-
-	return respBody, nil
+	return &response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, nil
 }
 
 // NewClient creates a new Hightouch API client.
 // It requires an API key, which can be generated from your Hightouch workspace settings.
-func NewClient(apiKey string, apiBaseUrl string) *Client {
-	return &Client{
-		apiKey:     apiKey,
-		httpClient: &http.Client{Timeout: 15 * time.Second},
-		baseURL:    apiBaseUrl,
+func NewClient(apiKey string, apiBaseUrl string, opts ...ClientOption) *Client {
+	c := &Client{
+		apiKey:         apiKey,
+		httpClient:     &http.Client{},
+		baseURL:        apiBaseUrl,
+		requestTimeout: DefaultRequestTimeout,
+		retryPolicy:    DefaultRetryPolicy(),
+		limiter:        &limiter{},
+		redactor:       newRedactor(),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
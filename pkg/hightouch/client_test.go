@@ -0,0 +1,410 @@
+package hightouch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-hightouch/pkg/hightouch"
+	"terraform-provider-hightouch/pkg/hightouch/hightouchtest"
+)
+
+func newTestClient(t *testing.T) (*hightouch.Client, *hightouchtest.Server) {
+	t.Helper()
+	server := hightouchtest.NewServer(t)
+	return hightouch.NewClient(hightouchtest.APIKey, server.URL), server
+}
+
+func TestClientLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, server := newTestClient(t)
+
+	source, err := client.CreateHightouchSource(ctx, "Warehouse", "warehouse", "snowflake", map[string]interface{}{
+		"account":  "acme",
+		"password": "hunter2",
+	})
+	if err != nil {
+		t.Fatalf("CreateHightouchSource() error = %v", err)
+	}
+	if source.ID == nil || source.WorkspaceID != hightouchtest.WorkspaceID {
+		t.Fatalf("CreateHightouchSource() = %+v, want an ID and workspace", source)
+	}
+
+	model, err := client.CreateHightouchModel(ctx, "Users", "users", *source.ID, "select * from users", "raw_sql", "id")
+	if err != nil {
+		t.Fatalf("CreateHightouchModel() error = %v", err)
+	}
+
+	destination, err := client.CreateHightouchDestination(ctx, "Iterable", "iterable", "iterable", map[string]interface{}{
+		"api_key": "secret",
+	})
+	if err != nil {
+		t.Fatalf("CreateHightouchDestination() error = %v", err)
+	}
+
+	sync, err := client.CreateHightouchSync(ctx, "Users to Iterable", "users-to-iterable", *source.ID, *destination.ID, *model.ID,
+		map[string]interface{}{"mode": "upsert"}, map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("CreateHightouchSync() error = %v", err)
+	}
+
+	updated, err := client.UpdateHightouchSync(ctx, *sync.ID, "Renamed", map[string]interface{}{"mode": "update"}, map[string]interface{}{}, true)
+	if err != nil {
+		t.Fatalf("UpdateHightouchSync() error = %v", err)
+	}
+	if updated.Name != "Renamed" || !updated.Disabled || updated.Configuration["mode"] != "update" {
+		t.Errorf("UpdateHightouchSync() = %+v, want the updated name, configuration and disabled flag", updated)
+	}
+
+	// Objects that are still referenced cannot be deleted.
+	var dependentsErr *hightouch.DependentObjectsError
+	if err := client.DeleteHightouchSource(ctx, *source.ID); !errors.As(err, &dependentsErr) {
+		t.Errorf("DeleteHightouchSource() error = %v, want a DependentObjectsError", err)
+	}
+
+	if err := client.DeleteHightouchSync(ctx, *sync.ID); err != nil {
+		t.Fatalf("DeleteHightouchSync() error = %v", err)
+	}
+	if err := client.DeleteHightouchModel(ctx, *model.ID); err != nil {
+		t.Fatalf("DeleteHightouchModel() error = %v", err)
+	}
+	if err := client.DeleteHightouchDestination(ctx, *destination.ID); err != nil {
+		t.Fatalf("DeleteHightouchDestination() error = %v", err)
+	}
+	if err := client.DeleteHightouchSource(ctx, *source.ID); err != nil {
+		t.Fatalf("DeleteHightouchSource() error = %v", err)
+	}
+	if server.HasSource(*source.ID) || server.HasSync(*sync.ID) {
+		t.Error("objects still exist on the server after deletion")
+	}
+
+	// Deleting twice is not an error, but reading a deleted object is a 404.
+	if err := client.DeleteHightouchSync(ctx, *sync.ID); err != nil {
+		t.Errorf("second DeleteHightouchSync() error = %v, want nil", err)
+	}
+	if _, err := client.GetHightouchSync(ctx, *sync.ID); !hightouch.IsNotFound(err) {
+		t.Errorf("GetHightouchSync() error = %v, want a not found error", err)
+	}
+}
+
+func TestClientValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	_, err := client.CreateHightouchModel(ctx, "Users", "", 999, "select 1", "raw_sql", "id")
+
+	var apiErr hightouch.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("CreateHightouchModel() error = %v, want an APIError", err)
+	}
+	if apiErr.StatusCode != 400 || apiErr.FieldErrors()["slug"] == nil || apiErr.FieldErrors()["sourceId"] == nil {
+		t.Errorf("APIError = %+v, want a 400 with slug and sourceId details", apiErr)
+	}
+}
+
+func TestClientRejectsInvalidAPIKey(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+	client := hightouch.NewClient("wrong", server.URL)
+
+	_, err := client.GetHightouchSource(context.Background(), 1)
+	var apiErr hightouch.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 401 {
+		t.Errorf("GetHightouchSource() error = %v, want a 401 APIError", err)
+	}
+}
+
+func TestClientListPaginates(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	// More than one page of sources.
+	for i := 0; i < 150; i++ {
+		slug := fmt.Sprintf("source-%03d", i)
+		if _, err := client.CreateHightouchSource(ctx, slug, slug, "postgres", nil); err != nil {
+			t.Fatalf("CreateHightouchSource() error = %v", err)
+		}
+	}
+
+	sources, err := client.ListHightouchSources(ctx, hightouch.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListHightouchSources() error = %v", err)
+	}
+	if len(sources) != 150 {
+		t.Errorf("ListHightouchSources() returned %d sources, want 150", len(sources))
+	}
+
+	sources, err = client.ListHightouchSources(ctx, hightouch.ListOptions{Slug: "source-042"})
+	if err != nil {
+		t.Fatalf("ListHightouchSources() error = %v", err)
+	}
+	if len(sources) != 1 || sources[0].Slug != "source-042" {
+		t.Errorf("ListHightouchSources(slug) = %+v, want only source-042", sources)
+	}
+}
+
+func TestClientFind(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	for _, slug := range []string{"primary", "replica"} {
+		if _, err := client.CreateHightouchSource(ctx, "Warehouse", slug, "snowflake", nil); err != nil {
+			t.Fatalf("CreateHightouchSource() error = %v", err)
+		}
+	}
+
+	source, err := client.FindHightouchSource(ctx, hightouch.ListOptions{Slug: "replica"})
+	if err != nil {
+		t.Fatalf("FindHightouchSource(slug) error = %v", err)
+	}
+	if source.Slug != "replica" {
+		t.Errorf("FindHightouchSource(slug) = %+v, want the replica source", source)
+	}
+
+	var lookupErr *hightouch.LookupError
+	_, err = client.FindHightouchSource(ctx, hightouch.ListOptions{Name: "Warehouse"})
+	if !errors.As(err, &lookupErr) || len(lookupErr.IDs) != 2 {
+		t.Errorf("FindHightouchSource(ambiguous name) error = %v, want a LookupError with 2 IDs", err)
+	}
+
+	_, err = client.FindHightouchSource(ctx, hightouch.ListOptions{Slug: "missing"})
+	if !errors.As(err, &lookupErr) || len(lookupErr.IDs) != 0 {
+		t.Errorf("FindHightouchSource(missing slug) error = %v, want a LookupError with no IDs", err)
+	}
+}
+
+func TestClientFindOfType(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+
+	snowflake, err := client.CreateHightouchSource(ctx, "Warehouse", "snowflake-warehouse", "snowflake", nil)
+	if err != nil {
+		t.Fatalf("CreateHightouchSource() error = %v", err)
+	}
+	bigquery, err := client.CreateHightouchSource(ctx, "Warehouse", "bigquery-warehouse", "bigquery", nil)
+	if err != nil {
+		t.Fatalf("CreateHightouchSource() error = %v", err)
+	}
+
+	// The BigQuery source does not make the name ambiguous.
+	source, err := client.FindHightouchSourceOfType(ctx, "snowflake", hightouch.ListOptions{Name: "Warehouse"})
+	if err != nil {
+		t.Fatalf("FindHightouchSourceOfType(name) error = %v", err)
+	}
+	if *source.ID != *snowflake.ID {
+		t.Errorf("FindHightouchSourceOfType(name) = %+v, want the Snowflake source", source)
+	}
+
+	var lookupErr *hightouch.LookupError
+	_, err = client.FindHightouchSourceOfType(ctx, "snowflake", hightouch.ListOptions{Slug: "bigquery-warehouse"})
+	if !errors.As(err, &lookupErr) || len(lookupErr.IDs) != 0 {
+		t.Errorf("FindHightouchSourceOfType(slug of another type) error = %v, want a LookupError with no IDs", err)
+	}
+
+	var typeErr *hightouch.SourceTypeError
+	_, err = client.GetHightouchSourceOfType(ctx, "snowflake", *bigquery.ID)
+	if !errors.As(err, &typeErr) || typeErr.Type != "bigquery" {
+		t.Errorf("GetHightouchSourceOfType(BigQuery source) error = %v, want a SourceTypeError", err)
+	}
+	if source, err := client.GetHightouchSourceOfType(ctx, "snowflake", *snowflake.ID); err != nil || source.Slug != "snowflake-warehouse" {
+		t.Errorf("GetHightouchSourceOfType() = %+v, %v, want the Snowflake source", source, err)
+	}
+}
+
+func TestClientSyncRuns(t *testing.T) {
+	ctx := context.Background()
+	client, server := newTestClient(t)
+
+	source, err := client.CreateHightouchSource(ctx, "Warehouse", "warehouse", "snowflake", nil)
+	if err != nil {
+		t.Fatalf("CreateHightouchSource() error = %v", err)
+	}
+	model, err := client.CreateHightouchModel(ctx, "Users", "users", *source.ID, "select * from users", "raw_sql", "id")
+	if err != nil {
+		t.Fatalf("CreateHightouchModel() error = %v", err)
+	}
+	destination, err := client.CreateHightouchDestination(ctx, "Iterable", "iterable", "iterable", nil)
+	if err != nil {
+		t.Fatalf("CreateHightouchDestination() error = %v", err)
+	}
+	sync, err := client.CreateHightouchSync(ctx, "Users to Iterable", "users-to-iterable", *source.ID, *destination.ID, *model.ID,
+		map[string]interface{}{}, map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("CreateHightouchSync() error = %v", err)
+	}
+
+	// The first run finishes after being polled twice.
+	server.SetSyncRunOutcome(2, "success", "")
+	firstID, err := client.TriggerSync(ctx, *sync.ID, true)
+	if err != nil {
+		t.Fatalf("TriggerSync() error = %v", err)
+	}
+	run, err := client.WaitForSyncRun(ctx, *sync.ID, firstID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForSyncRun() error = %v", err)
+	}
+	if !run.Finished() || !run.Succeeded() || run.Failed() || run.FinishedAt == nil || run.SuccessfulRows.AddedCount != 10 {
+		t.Errorf("WaitForSyncRun() = %+v, want a successful finished run", run)
+	}
+	if runs := server.SyncRuns(*sync.ID); len(runs) != 1 || !runs[0].FullResync {
+		t.Errorf("server runs = %+v, want one full resync", runs)
+	}
+
+	// The second run fails.
+	server.SetSyncRunOutcome(0, "failed", "destination rejected the batch")
+	secondID, err := client.TriggerSync(ctx, *sync.ID, false)
+	if err != nil {
+		t.Fatalf("TriggerSync() error = %v", err)
+	}
+	run, err = client.GetSyncRun(ctx, *sync.ID, secondID)
+	if err != nil {
+		t.Fatalf("GetSyncRun() error = %v", err)
+	}
+	if run.Succeeded() || !run.Failed() || run.Error == nil || *run.Error != "destination rejected the batch" {
+		t.Errorf("GetSyncRun() = %+v, want a failed run with an error message", run)
+	}
+
+	runs, err := client.ListSyncRuns(ctx, *sync.ID, hightouch.ListSyncRunsOptions{})
+	if err != nil {
+		t.Fatalf("ListSyncRuns() error = %v", err)
+	}
+	if len(runs) != 2 || runs[0].ID != secondID || runs[1].ID != firstID {
+		t.Errorf("ListSyncRuns() = %+v, want both runs, most recent first", runs)
+	}
+	runs, err = client.ListSyncRuns(ctx, *sync.ID, hightouch.ListSyncRunsOptions{Limit: 1})
+	if err != nil || len(runs) != 1 || runs[0].ID != secondID {
+		t.Errorf("ListSyncRuns(limit 1) = %+v, %v, want the most recent run", runs, err)
+	}
+	runs, err = client.ListSyncRuns(ctx, *sync.ID, hightouch.ListSyncRunsOptions{Statuses: []string{"success", "warning"}, Limit: 1})
+	if err != nil || len(runs) != 1 || runs[0].ID != firstID {
+		t.Errorf("ListSyncRuns(successful) = %+v, %v, want the first run", runs, err)
+	}
+	runs, err = client.ListSyncRuns(ctx, *sync.ID, hightouch.ListSyncRunsOptions{After: time.Now().Add(-time.Hour)})
+	if err != nil || len(runs) != 2 {
+		t.Errorf("ListSyncRuns(after an hour ago) = %+v, %v, want both runs", runs, err)
+	}
+	runs, err = client.ListSyncRuns(ctx, *sync.ID, hightouch.ListSyncRunsOptions{Before: time.Now().Add(-time.Hour)})
+	if err != nil || len(runs) != 0 {
+		t.Errorf("ListSyncRuns(before an hour ago) = %+v, %v, want no runs", runs, err)
+	}
+
+	if _, err := client.GetSyncRun(ctx, *sync.ID, 999); !hightouch.IsNotFound(err) {
+		t.Errorf("GetSyncRun(missing) error = %v, want a not found error", err)
+	}
+}
+
+func TestClientWaitForSyncRunTimesOut(t *testing.T) {
+	client, server := newTestClient(t)
+	ctx := context.Background()
+
+	source, _ := client.CreateHightouchSource(ctx, "Warehouse", "warehouse", "snowflake", nil)
+	model, _ := client.CreateHightouchModel(ctx, "Users", "users", *source.ID, "select * from users", "raw_sql", "id")
+	destination, _ := client.CreateHightouchDestination(ctx, "Iterable", "iterable", "iterable", nil)
+	sync, err := client.CreateHightouchSync(ctx, "Users to Iterable", "users-to-iterable", *source.ID, *destination.ID, *model.ID,
+		map[string]interface{}{}, map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("CreateHightouchSync() error = %v", err)
+	}
+
+	server.SetSyncRunOutcome(1000000, "success", "")
+	runID, err := client.TriggerSync(ctx, *sync.ID, false)
+	if err != nil {
+		t.Fatalf("TriggerSync() error = %v", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	run, err := client.WaitForSyncRun(waitCtx, *sync.ID, runID, 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForSyncRun() error = %v, want a deadline exceeded error", err)
+	}
+	if run == nil || run.Status != "processing" {
+		t.Errorf("WaitForSyncRun() = %+v, want the last seen processing run", run)
+	}
+}
+
+func TestClientWaitForSyncRunBoundsEachPoll(t *testing.T) {
+	server := hightouchtest.NewServer(t)
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first poll of the run hangs until the client gives up on it.
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	var polls atomic.Int32
+	stalling := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Query().Has("runId") && polls.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	t.Cleanup(stalling.Close)
+
+	client := hightouch.NewClient(hightouchtest.APIKey, stalling.URL,
+		hightouch.WithRequestTimeout(20*time.Millisecond),
+		hightouch.WithRetryPolicy(hightouch.RetryPolicy{MaxAttempts: 1}),
+	)
+	ctx := context.Background()
+	source, _ := client.CreateHightouchSource(ctx, "Warehouse", "warehouse", "snowflake", nil)
+	model, _ := client.CreateHightouchModel(ctx, "Users", "users", *source.ID, "select * from users", "raw_sql", "id")
+	destination, _ := client.CreateHightouchDestination(ctx, "Iterable", "iterable", "iterable", nil)
+	sync, err := client.CreateHightouchSync(ctx, "Users to Iterable", "users-to-iterable", *source.ID, *destination.ID, *model.ID,
+		map[string]interface{}{}, map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("CreateHightouchSync() error = %v", err)
+	}
+	server.SetSyncRunOutcome(1, "success", "")
+	runID, err := client.TriggerSync(ctx, *sync.ID, false)
+	if err != nil {
+		t.Fatalf("TriggerSync() error = %v", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	run, err := client.WaitForSyncRun(waitCtx, *sync.ID, runID, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForSyncRun() error = %v", err)
+	}
+	if !run.Succeeded() {
+		t.Errorf("WaitForSyncRun() = %+v, want a successful run", run)
+	}
+	if n := polls.Load(); n < 2 {
+		t.Errorf("polls = %d, want the stalled poll to be retried", n)
+	}
+}
+
+func TestClientListSyncRunsBoundsStatusFilter(t *testing.T) {
+	// Every page is full of failed runs and there is always another one.
+	var pages atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+		runs := make([]hightouch.SyncRun, 100)
+		for i := range runs {
+			runs[i] = hightouch.SyncRun{ID: i + 1, Status: "failed"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": runs, "hasMore": true})
+	}))
+	t.Cleanup(server.Close)
+	client := hightouch.NewClient(hightouchtest.APIKey, server.URL)
+
+	runs, err := client.ListSyncRuns(context.Background(), 1, hightouch.ListSyncRunsOptions{Statuses: []string{"success"}, Limit: 1})
+	if !errors.Is(err, hightouch.ErrStatusFilterLimit) {
+		t.Fatalf("ListSyncRuns() error = %v, want %v", err, hightouch.ErrStatusFilterLimit)
+	}
+	if len(runs) != 0 {
+		t.Errorf("ListSyncRuns() = %+v, want no runs", runs)
+	}
+	if got, want := int(pages.Load()), hightouch.MaxStatusFilteredRuns/100; got != want {
+		t.Errorf("pages read = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,44 @@
+package hightouch
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependentObjectsError is returned when an object cannot be deleted because
+// other Hightouch objects still reference it, e.g. a source with models.
+type DependentObjectsError struct {
+	// Kind is the kind of object being deleted, e.g. "source".
+	Kind string
+	// ID is the ID of the object being deleted.
+	ID int
+	// Dependents describes the kind of objects that still reference it, e.g. "models".
+	Dependents string
+	// Err is the underlying API error.
+	Err error
+}
+
+func (e *DependentObjectsError) Error() string {
+	return fmt.Sprintf(
+		"cannot delete %s %d while it still has dependent %s; delete the %s first: %s",
+		e.Kind, e.ID, e.Dependents, e.Dependents, e.Err,
+	)
+}
+
+func (e *DependentObjectsError) Unwrap() error {
+	return e.Err
+}
+
+// deleteObject deletes the object at path. A 404 is treated as the object
+// already being deleted, and a 409 is reported as a DependentObjectsError.
+func (c *Client) deleteObject(ctx context.Context, kind string, id int, dependents string, path string) error {
+	_, err := c.makeRequest(ctx, "DELETE", path, nil)
+	switch {
+	case err == nil, IsNotFound(err):
+		return nil
+	case IsConflict(err) && dependents != "":
+		return &DependentObjectsError{Kind: kind, ID: id, Dependents: dependents, Err: err}
+	default:
+		return err
+	}
+}
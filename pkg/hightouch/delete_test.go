@@ -0,0 +1,49 @@
+package hightouch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteHightouchSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		wantErr        bool
+		wantDependents bool
+	}{
+		{name: "deleted", status: http.StatusNoContent},
+		{name: "already deleted", status: http.StatusNotFound},
+		{name: "has dependent models", status: http.StatusConflict, wantErr: true, wantDependents: true},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/sources/42" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				if tt.status != http.StatusNoContent {
+					_, _ = w.Write([]byte(`{"message":"nope"}`))
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)))
+			err := client.DeleteHightouchSource(context.Background(), 42)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteHightouchSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var depErr *DependentObjectsError
+			if got := errors.As(err, &depErr); got != tt.wantDependents {
+				t.Errorf("errors.As(DependentObjectsError) = %t, want %t", got, tt.wantDependents)
+			}
+		})
+	}
+}
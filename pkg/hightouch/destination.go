@@ -1,6 +1,7 @@
 package hightouch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -20,12 +21,14 @@ type HightouchDestination struct {
 
 // GetHightouchDestination retrieves a specific destination by its ID.
 func (c *Client) GetHightouchDestination(
+	ctx context.Context,
 	destinationID int,
 ) (*HightouchDestination, error) {
 
 	var destination HightouchDestination
 
 	respBody, err := c.makeRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf("/destinations/%d", destinationID),
 		nil,
@@ -43,6 +46,7 @@ func (c *Client) GetHightouchDestination(
 
 // CreateHightouchDestination creates a new destination in Hightouch.
 func (c *Client) CreateHightouchDestination(
+	ctx context.Context,
 	name string,
 	slug string,
 	destinationType string,
@@ -62,6 +66,7 @@ func (c *Client) CreateHightouchDestination(
 
 	var destination HightouchDestination
 	respBody, err := c.makeRequest(
+		ctx,
 		"POST",
 		"/destinations",
 		requestBody,
@@ -81,6 +86,7 @@ func (c *Client) CreateHightouchDestination(
 // UpdateHightouchDestination updates a specific destination.
 // The name and configuration parameters can be updated.
 func (c *Client) UpdateHightouchDestination(
+	ctx context.Context,
 	destinationID int,
 	name string,
 	configuration map[string]interface{},
@@ -95,6 +101,7 @@ func (c *Client) UpdateHightouchDestination(
 
 	var destination HightouchDestination
 	respBody, err := c.makeRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf("/destinations/%d", destinationID),
 		requestBody,
@@ -110,3 +117,37 @@ func (c *Client) UpdateHightouchDestination(
 
 	return &destination, nil
 }
+
+// DeleteHightouchDestination deletes a specific destination.
+// Deleting a destination that no longer exists is not an error. If the destination still has
+// dependent syncs, a *DependentObjectsError is returned.
+func (c *Client) DeleteHightouchDestination(
+	ctx context.Context,
+	destinationID int,
+) error {
+	return c.deleteObject(ctx, "destination", destinationID, "syncs", fmt.Sprintf("/destinations/%d", destinationID))
+}
+
+// ListHightouchDestinations retrieves all destinations matching opts, following pagination.
+func (c *Client) ListHightouchDestinations(
+	ctx context.Context,
+	opts ListOptions,
+) ([]HightouchDestination, error) {
+	return listAll[HightouchDestination](ctx, c, "/destinations", opts.query())
+}
+
+// FindHightouchDestination retrieves the single destination whose slug or name matches
+// opts exactly. A *LookupError is returned if no destination, or more than one,
+// matches.
+func (c *Client) FindHightouchDestination(
+	ctx context.Context,
+	opts ListOptions,
+) (*HightouchDestination, error) {
+	destinations, err := c.ListHightouchDestinations(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return findOne("destination", opts, destinations, func(d HightouchDestination) (int, string, string) {
+		return *d.ID, d.Name, d.Slug
+	})
+}
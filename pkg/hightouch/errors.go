@@ -0,0 +1,124 @@
+package hightouch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// requestIDHeader is the response header carrying the ID Hightouch assigns to
+// each API request. Include it when reporting problems to Hightouch support.
+const requestIDHeader = "X-Request-Id"
+
+// APIError represents an error response from the Hightouch API.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// RequestID is the ID Hightouch assigned to the failed request, if any.
+	RequestID string `json:"-"`
+	// Message is the human-readable error message returned by the API.
+	Message string `json:"message"`
+	// Details holds further details of the error as returned by the API,
+	// usually an object of per-field validation errors keyed by field name.
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// FieldErrors returns the per-field validation errors in Details, or nil if
+// Details is not an object.
+func (e APIError) FieldErrors() map[string]interface{} {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(e.Details, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+func (e APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Hightouch API Error (HTTP %d", e.StatusCode)
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", request ID %s", e.RequestID)
+	}
+	fmt.Fprintf(&b, "): %s", e.Message)
+
+	if fieldErrors := e.FieldErrors(); fieldErrors != nil {
+		if len(fieldErrors) > 0 {
+			fields := make([]string, 0, len(fieldErrors))
+			for field := range fieldErrors {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+
+			details := make([]string, 0, len(fields))
+			for _, field := range fields {
+				details = append(details, fmt.Sprintf("%s: %v", field, fieldErrors[field]))
+			}
+			fmt.Fprintf(&b, " (%s)", strings.Join(details, "; "))
+		}
+	} else if details := formatDetails(e.Details); details != "" {
+		fmt.Fprintf(&b, " (%s)", details)
+	}
+
+	return b.String()
+}
+
+// formatDetails formats details that are not an object, such as a list of
+// messages or a single string, for an error message.
+func formatDetails(details json.RawMessage) string {
+	var message string
+	if err := json.Unmarshal(details, &message); err == nil {
+		return message
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, details); err != nil {
+		return strings.TrimSpace(string(details))
+	}
+	if compact.String() == "null" {
+		return ""
+	}
+	return compact.String()
+}
+
+// newAPIError builds an APIError from a non-successful API response.
+func newAPIError(resp *response) APIError {
+	apiErr := APIError{}
+	if err := json.Unmarshal(resp.Body, &apiErr); err != nil || apiErr.Message == "" {
+		// If we can't parse the error, fall back to the raw body
+		apiErr = APIError{Message: strings.TrimSpace(string(resp.Body))}
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+	}
+	apiErr.StatusCode = resp.StatusCode
+	apiErr.RequestID = resp.Header.Get(requestIDHeader)
+	return apiErr
+}
+
+// hasStatus reports whether err is an APIError with the given status code.
+func hasStatus(err error, statusCode int) bool {
+	var apiErr APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an API error caused by the requested
+// object not existing.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an API error caused by a conflict with the
+// current state of the object, such as deleting an object that is still in use.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an API error caused by rate limiting
+// that persisted through all retries.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
@@ -0,0 +1,106 @@
+package hightouch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMakeRequestReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-123")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Validation failed","details":{"slug":"must be unique","name":"is required"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)))
+	_, err := client.makeRequest(context.Background(), http.MethodPost, "/syncs", nil)
+
+	var apiErr APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("makeRequest() error = %v, want an APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.RequestID != "req-123" || apiErr.Message != "Validation failed" {
+		t.Errorf("APIError = %+v, want status 400, request ID req-123 and message %q", apiErr, "Validation failed")
+	}
+	if apiErr.FieldErrors()["slug"] != "must be unique" {
+		t.Errorf("APIError.FieldErrors() = %v, want the slug validation error", apiErr.FieldErrors())
+	}
+
+	want := "Hightouch API Error (HTTP 400, request ID req-123): Validation failed (name: is required; slug: must be unique)"
+	if got := apiErr.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorNonObjectDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "list",
+			body: `{"message":"Invalid configuration","details":["host is required", "port must be a number"]}`,
+			want: `Hightouch API Error (HTTP 400): Invalid configuration (["host is required","port must be a number"])`,
+		},
+		{
+			name: "string",
+			body: `{"message":"Invalid configuration","details":"host is required"}`,
+			want: "Hightouch API Error (HTTP 400): Invalid configuration (host is required)",
+		},
+		{
+			name: "null",
+			body: `{"message":"Invalid configuration","details":null}`,
+			want: "Hightouch API Error (HTTP 400): Invalid configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := newAPIError(&response{
+				StatusCode: http.StatusBadRequest,
+				Header:     http.Header{},
+				Body:       []byte(tt.body),
+			})
+			if apiErr.FieldErrors() != nil {
+				t.Errorf("FieldErrors() = %v, want nil", apiErr.FieldErrors())
+			}
+			if got := apiErr.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorUnparsableBody(t *testing.T) {
+	apiErr := newAPIError(&response{
+		StatusCode: http.StatusBadGateway,
+		Header:     http.Header{},
+		Body:       []byte("<html>Bad Gateway</html>"),
+	})
+	if apiErr.StatusCode != http.StatusBadGateway || !strings.Contains(apiErr.Message, "Bad Gateway") {
+		t.Errorf("newAPIError() = %+v, want status 502 and the raw body as message", apiErr)
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	notFound := fmt.Errorf("reading sync: %w", APIError{StatusCode: http.StatusNotFound})
+	conflict := APIError{StatusCode: http.StatusConflict}
+	throttled := APIError{StatusCode: http.StatusTooManyRequests}
+	other := errors.New("connection refused")
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) || IsNotFound(other) {
+		t.Error("IsNotFound() only matches wrapped 404 API errors")
+	}
+	if !IsConflict(conflict) || IsConflict(notFound) {
+		t.Error("IsConflict() only matches 409 API errors")
+	}
+	if !IsRateLimited(throttled) || IsRateLimited(other) {
+		t.Error("IsRateLimited() only matches 429 API errors")
+	}
+}
@@ -0,0 +1,1022 @@
+// Package hightouchtest provides an in-memory fake of the Hightouch REST API
+// for use in tests. It supports sources, models, destinations, syncs and sync
+// runs, assigns IDs and timestamps like the real API, and returns realistic
+// validation, not-found and conflict errors.
+package hightouchtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// APIKey is the only API key accepted by the fake server.
+const APIKey = "hightouch-test-key"
+
+// WorkspaceID is the workspace every object created on the fake server belongs to.
+const WorkspaceID = 1
+
+// SyncRun is a sync run recorded by the fake server.
+type SyncRun struct {
+	ID              int        `json:"id"`
+	SyncID          int        `json:"syncId"`
+	Status          string     `json:"status"`
+	FullResync      bool       `json:"fullResync"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	StartedAt       time.Time  `json:"startedAt"`
+	FinishedAt      *time.Time `json:"finishedAt"`
+	PlannedRows     RowCounts  `json:"plannedRows"`
+	SuccessfulRows  RowCounts  `json:"successfulRows"`
+	FailedRows      RowCounts  `json:"failedRows"`
+	QuerySize       int        `json:"querySize"`
+	CompletionRatio float64    `json:"completionRatio"`
+	Error           *string    `json:"error"`
+
+	// pendingReads is the number of reads left before the run finishes.
+	pendingReads int
+	outcome      syncRunOutcome
+}
+
+// syncRunOutcome is how runs triggered on the fake server behave.
+type syncRunOutcome struct {
+	polls   int
+	status  string
+	message string
+}
+
+// RowCounts holds per-operation row counts of a sync run.
+type RowCounts struct {
+	AddedCount   int `json:"addedCount"`
+	ChangedCount int `json:"changedCount"`
+	RemovedCount int `json:"removedCount"`
+}
+
+// Server is an in-memory fake of the Hightouch API backed by httptest.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	nextID       int
+	sources      map[int]*hightouch.HightouchSource
+	models       map[int]*hightouch.HightouchModel
+	destinations map[int]*hightouch.HightouchDestination
+	syncs        map[int]*hightouch.HightouchSync
+	syncRuns     map[int][]*SyncRun
+	runOutcome   syncRunOutcome
+}
+
+// NewServer starts a fake Hightouch API server that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		nextID:       1,
+		sources:      make(map[int]*hightouch.HightouchSource),
+		models:       make(map[int]*hightouch.HightouchModel),
+		destinations: make(map[int]*hightouch.HightouchDestination),
+		syncs:        make(map[int]*hightouch.HightouchSync),
+		syncRuns:     make(map[int][]*SyncRun),
+		runOutcome:   syncRunOutcome{status: "success"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /sources", s.listSources)
+	mux.HandleFunc("POST /sources", s.createSource)
+	mux.HandleFunc("GET /sources/{id}", s.getSource)
+	mux.HandleFunc("PATCH /sources/{id}", s.updateSource)
+	mux.HandleFunc("DELETE /sources/{id}", s.deleteSource)
+
+	mux.HandleFunc("GET /models", s.listModels)
+	mux.HandleFunc("POST /models", s.createModel)
+	mux.HandleFunc("GET /models/{id}", s.getModel)
+	mux.HandleFunc("PATCH /models/{id}", s.updateModel)
+	mux.HandleFunc("DELETE /models/{id}", s.deleteModel)
+
+	mux.HandleFunc("GET /destinations", s.listDestinations)
+	mux.HandleFunc("POST /destinations", s.createDestination)
+	mux.HandleFunc("GET /destinations/{id}", s.getDestination)
+	mux.HandleFunc("PATCH /destinations/{id}", s.updateDestination)
+	mux.HandleFunc("DELETE /destinations/{id}", s.deleteDestination)
+
+	mux.HandleFunc("GET /syncs", s.listSyncs)
+	mux.HandleFunc("POST /syncs", s.createSync)
+	mux.HandleFunc("GET /syncs/{id}", s.getSync)
+	mux.HandleFunc("PATCH /syncs/{id}", s.updateSync)
+	mux.HandleFunc("DELETE /syncs/{id}", s.deleteSync)
+	mux.HandleFunc("POST /syncs/{id}/trigger", s.triggerSync)
+	mux.HandleFunc("GET /syncs/{id}/runs", s.listSyncRuns)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// HasSource reports whether a source with the given ID exists.
+func (s *Server) HasSource(id int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.sources[id]
+	return ok
+}
+
+// HasModel reports whether a model with the given ID exists.
+func (s *Server) HasModel(id int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.models[id]
+	return ok
+}
+
+// HasDestination reports whether a destination with the given ID exists.
+func (s *Server) HasDestination(id int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.destinations[id]
+	return ok
+}
+
+// HasSync reports whether a sync with the given ID exists.
+func (s *Server) HasSync(id int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.syncs[id]
+	return ok
+}
+
+// DeleteModel removes a model directly from the store, even if syncs use it,
+// simulating a deletion made outside of Terraform.
+func (s *Server) DeleteModel(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.models, id)
+}
+
+// DeleteSync removes a sync directly from the store, simulating a deletion
+// made outside of Terraform, e.g. in the Hightouch UI.
+func (s *Server) DeleteSync(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.syncs, id)
+	delete(s.syncRuns, id)
+}
+
+// SetSyncRunOutcome makes runs triggered afterwards report "processing" for
+// their first polls reads, then finish with status. A non-empty message is
+// reported as the error of the run.
+func (s *Server) SetSyncRunOutcome(polls int, status, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runOutcome = syncRunOutcome{polls: polls, status: status, message: message}
+}
+
+// SyncRuns returns copies of the runs of a sync, oldest first.
+func (s *Server) SyncRuns(syncID int) []SyncRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs := make([]SyncRun, 0, len(s.syncRuns[syncID]))
+	for _, run := range s.syncRuns[syncID] {
+		runs = append(runs, *run)
+	}
+	return runs
+}
+
+// SyncConfiguration returns a copy of the stored configuration of a sync, or
+// nil if the sync does not exist.
+func (s *Server) SyncConfiguration(id int) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sync, ok := s.syncs[id]
+	if !ok {
+		return nil
+	}
+	return copyMap(sync.Configuration)
+}
+
+// SetSyncConfiguration merges values into the configuration of a sync,
+// simulating changes made by Hightouch itself, such as defaults filled in by
+// the API.
+func (s *Server) SetSyncConfiguration(id int, values map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sync, ok := s.syncs[id]; ok {
+		sync.Configuration = orEmpty(sync.Configuration)
+		for key, value := range values {
+			sync.Configuration[key] = value
+		}
+	}
+}
+
+// SourceConfiguration returns a copy of the stored configuration of a source,
+// including credentials, or nil if the source does not exist.
+func (s *Server) SourceConfiguration(id int) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	source, ok := s.sources[id]
+	if !ok {
+		return nil
+	}
+	return copyMap(source.Configuration)
+}
+
+// SetSourceConfiguration merges values into the configuration of a source,
+// simulating changes made by Hightouch itself, such as defaults filled in by
+// the API.
+func (s *Server) SetSourceConfiguration(id int, values map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if source, ok := s.sources[id]; ok {
+		source.Configuration = orEmpty(source.Configuration)
+		for key, value := range values {
+			source.Configuration[key] = value
+		}
+	}
+}
+
+// DestinationConfiguration returns a copy of the stored configuration of a
+// destination, including credentials, or nil if the destination does not exist.
+func (s *Server) DestinationConfiguration(id int) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	destination, ok := s.destinations[id]
+	if !ok {
+		return nil
+	}
+	return copyMap(destination.Configuration)
+}
+
+// SetDestinationConfiguration merges values into the configuration of a
+// destination, simulating changes made by Hightouch itself, such as defaults
+// filled in by the API.
+func (s *Server) SetDestinationConfiguration(id int, values map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if destination, ok := s.destinations[id]; ok {
+		destination.Configuration = orEmpty(destination.Configuration)
+		for key, value := range values {
+			destination.Configuration[key] = value
+		}
+	}
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+APIKey {
+			writeError(w, http.StatusUnauthorized, "Invalid API key", nil)
+			return
+		}
+		w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", time.Now().UnixNano()))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allocateID returns the next object ID. The caller must hold s.mu.
+func (s *Server) allocateID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) listSources(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list(w, r, s.sources, func(source *hightouch.HightouchSource) bool {
+		return matchesNameAndSlug(r, source.Name, source.Slug)
+	})
+}
+
+func (s *Server) createSource(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string                 `json:"name"`
+		Slug          string                 `json:"slug"`
+		Type          string                 `json:"type"`
+		Configuration map[string]interface{} `json:"configuration"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details := requireFields(map[string]string{"name": body.Name, "slug": body.Slug, "type": body.Type})
+	for _, source := range s.sources {
+		if source.Slug == body.Slug {
+			details["slug"] = "a source with this slug already exists"
+		}
+	}
+	if len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "Validation failed", details)
+		return
+	}
+
+	id := s.allocateID()
+	now := time.Now().UTC()
+	source := &hightouch.HightouchSource{
+		ID:            &id,
+		Name:          body.Name,
+		Slug:          body.Slug,
+		WorkspaceID:   WorkspaceID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		Type:          body.Type,
+		Configuration: orEmpty(body.Configuration),
+	}
+	s.sources[id] = source
+
+	writeJSON(w, http.StatusOK, source)
+}
+
+func (s *Server) getSource(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source, ok := lookup(w, r, s.sources, "Source")
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, source)
+}
+
+func (s *Server) updateSource(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          *string                `json:"name"`
+		Configuration map[string]interface{} `json:"configuration"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source, ok := lookup(w, r, s.sources, "Source")
+	if !ok {
+		return
+	}
+	if body.Name != nil {
+		source.Name = *body.Name
+	}
+	// Configuration updates are merged, so secrets that are omitted from an
+	// update are preserved.
+	for key, value := range body.Configuration {
+		source.Configuration[key] = value
+	}
+	source.UpdatedAt = time.Now().UTC()
+
+	writeJSON(w, http.StatusOK, source)
+}
+
+func (s *Server) deleteSource(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source, ok := lookup(w, r, s.sources, "Source")
+	if !ok {
+		return
+	}
+	for _, model := range s.models {
+		if model.SourceID == *source.ID {
+			writeError(w, http.StatusConflict, "Source is used by one or more models", map[string]interface{}{"models": model.Slug})
+			return
+		}
+	}
+	delete(s.sources, *source.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listModels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list(w, r, s.models, func(model *hightouch.HightouchModel) bool {
+		return matchesNameAndSlug(r, model.Name, model.Slug)
+	})
+}
+
+func (s *Server) createModel(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name       string `json:"name"`
+		Slug       string `json:"slug"`
+		SourceID   int    `json:"sourceId"`
+		SQL        string `json:"sql"`
+		QueryType  string `json:"queryType"`
+		PrimaryKey string `json:"primaryKey"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details := requireFields(map[string]string{"name": body.Name, "slug": body.Slug, "primaryKey": body.PrimaryKey})
+	if _, ok := s.sources[body.SourceID]; !ok {
+		details["sourceId"] = fmt.Sprintf("source %d does not exist", body.SourceID)
+	}
+	if body.QueryType == "raw_sql" || body.QueryType == "sql" {
+		if body.SQL == "" {
+			details["sql"] = "is required for SQL models"
+		}
+	}
+	for _, model := range s.models {
+		if model.Slug == body.Slug {
+			details["slug"] = "a model with this slug already exists"
+		}
+	}
+	if len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "Validation failed", details)
+		return
+	}
+
+	id := s.allocateID()
+	now := time.Now().UTC()
+	model := &hightouch.HightouchModel{
+		ID:          &id,
+		Name:        body.Name,
+		Slug:        body.Slug,
+		WorkspaceID: WorkspaceID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		SourceID:    body.SourceID,
+		SQL:         body.SQL,
+		QueryType:   body.QueryType,
+		PrimaryKey:  body.PrimaryKey,
+	}
+	s.models[id] = model
+
+	writeJSON(w, http.StatusOK, model)
+}
+
+func (s *Server) getModel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, ok := lookup(w, r, s.models, "Model")
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, model)
+}
+
+func (s *Server) updateModel(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name       *string `json:"name"`
+		SQL        *string `json:"sql"`
+		PrimaryKey *string `json:"primaryKey"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, ok := lookup(w, r, s.models, "Model")
+	if !ok {
+		return
+	}
+	if body.Name != nil {
+		model.Name = *body.Name
+	}
+	if body.SQL != nil {
+		model.SQL = *body.SQL
+	}
+	if body.PrimaryKey != nil {
+		model.PrimaryKey = *body.PrimaryKey
+	}
+	model.UpdatedAt = time.Now().UTC()
+
+	writeJSON(w, http.StatusOK, model)
+}
+
+func (s *Server) deleteModel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, ok := lookup(w, r, s.models, "Model")
+	if !ok {
+		return
+	}
+	for _, sync := range s.syncs {
+		if sync.ModelID == *model.ID {
+			writeError(w, http.StatusConflict, "Model is used by one or more syncs", map[string]interface{}{"syncs": sync.Slug})
+			return
+		}
+	}
+	delete(s.models, *model.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listDestinations(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list(w, r, s.destinations, func(destination *hightouch.HightouchDestination) bool {
+		return matchesNameAndSlug(r, destination.Name, destination.Slug)
+	})
+}
+
+func (s *Server) createDestination(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string                 `json:"name"`
+		Slug          string                 `json:"slug"`
+		Type          string                 `json:"type"`
+		Configuration map[string]interface{} `json:"configuration"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details := requireFields(map[string]string{"name": body.Name, "slug": body.Slug, "type": body.Type})
+	for _, destination := range s.destinations {
+		if destination.Slug == body.Slug {
+			details["slug"] = "a destination with this slug already exists"
+		}
+	}
+	if len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "Validation failed", details)
+		return
+	}
+
+	id := s.allocateID()
+	now := time.Now().UTC()
+	destination := &hightouch.HightouchDestination{
+		ID:            &id,
+		Name:          body.Name,
+		Slug:          body.Slug,
+		WorkspaceID:   WorkspaceID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		Type:          body.Type,
+		Syncs:         []int{},
+		Configuration: orEmpty(body.Configuration),
+	}
+	s.destinations[id] = destination
+
+	writeJSON(w, http.StatusOK, destination)
+}
+
+func (s *Server) getDestination(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	destination, ok := lookup(w, r, s.destinations, "Destination")
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, destination)
+}
+
+func (s *Server) updateDestination(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          *string                `json:"name"`
+		Configuration map[string]interface{} `json:"configuration"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	destination, ok := lookup(w, r, s.destinations, "Destination")
+	if !ok {
+		return
+	}
+	if body.Name != nil {
+		destination.Name = *body.Name
+	}
+	for key, value := range body.Configuration {
+		destination.Configuration[key] = value
+	}
+	destination.UpdatedAt = time.Now().UTC()
+
+	writeJSON(w, http.StatusOK, destination)
+}
+
+func (s *Server) deleteDestination(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	destination, ok := lookup(w, r, s.destinations, "Destination")
+	if !ok {
+		return
+	}
+	if len(destination.Syncs) > 0 {
+		writeError(w, http.StatusConflict, "Destination is used by one or more syncs", map[string]interface{}{"syncs": destination.Syncs})
+		return
+	}
+	delete(s.destinations, *destination.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listSyncs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	modelID := r.URL.Query().Get("modelId")
+	list(w, r, s.syncs, func(sync *hightouch.HightouchSync) bool {
+		return matchesNameAndSlug(r, sync.Name, sync.Slug) &&
+			(modelID == "" || modelID == strconv.Itoa(sync.ModelID))
+	})
+}
+
+func (s *Server) createSync(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string                 `json:"name"`
+		Slug          string                 `json:"slug"`
+		DestinationID int                    `json:"destinationId"`
+		ModelID       int                    `json:"modelId"`
+		Configuration map[string]interface{} `json:"configuration"`
+		Schedule      map[string]interface{} `json:"schedule"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details := requireFields(map[string]string{"name": body.Name, "slug": body.Slug})
+	if _, ok := s.models[body.ModelID]; !ok {
+		details["modelId"] = fmt.Sprintf("model %d does not exist", body.ModelID)
+	}
+	destination, ok := s.destinations[body.DestinationID]
+	if !ok {
+		details["destinationId"] = fmt.Sprintf("destination %d does not exist", body.DestinationID)
+	}
+	for _, sync := range s.syncs {
+		if sync.Slug == body.Slug {
+			details["slug"] = "a sync with this slug already exists"
+		}
+	}
+	if len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "Validation failed", details)
+		return
+	}
+
+	id := s.allocateID()
+	now := time.Now().UTC()
+	sync := &hightouch.HightouchSync{
+		ID:            &id,
+		Name:          body.Name,
+		Slug:          body.Slug,
+		WorkspaceID:   WorkspaceID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DestinationID: body.DestinationID,
+		ModelID:       body.ModelID,
+		Configuration: orEmpty(body.Configuration),
+		Schedule:      orEmpty(body.Schedule),
+		Status:        "pending",
+	}
+	s.syncs[id] = sync
+	destination.Syncs = append(destination.Syncs, id)
+
+	writeJSON(w, http.StatusOK, sync)
+}
+
+func (s *Server) getSync(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sync, ok := lookup(w, r, s.syncs, "Sync")
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, sync)
+}
+
+func (s *Server) updateSync(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          *string                `json:"name"`
+		Configuration map[string]interface{} `json:"configuration"`
+		Schedule      map[string]interface{} `json:"schedule"`
+		Disabled      *bool                  `json:"disabled"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sync, ok := lookup(w, r, s.syncs, "Sync")
+	if !ok {
+		return
+	}
+	if body.Name != nil {
+		sync.Name = *body.Name
+	}
+	if body.Configuration != nil {
+		sync.Configuration = body.Configuration
+	}
+	if body.Schedule != nil {
+		sync.Schedule = body.Schedule
+	}
+	if body.Disabled != nil {
+		sync.Disabled = *body.Disabled
+		if sync.Disabled {
+			sync.Status = "disabled"
+		} else {
+			sync.Status = "pending"
+		}
+	}
+	sync.UpdatedAt = time.Now().UTC()
+
+	writeJSON(w, http.StatusOK, sync)
+}
+
+func (s *Server) deleteSync(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sync, ok := lookup(w, r, s.syncs, "Sync")
+	if !ok {
+		return
+	}
+	if destination, ok := s.destinations[sync.DestinationID]; ok {
+		remaining := destination.Syncs[:0]
+		for _, id := range destination.Syncs {
+			if id != *sync.ID {
+				remaining = append(remaining, id)
+			}
+		}
+		destination.Syncs = remaining
+	}
+	delete(s.syncs, *sync.ID)
+	delete(s.syncRuns, *sync.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// triggerSync records a sync run that completes immediately.
+func (s *Server) triggerSync(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		FullResync bool `json:"fullResync"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sync, ok := lookup(w, r, s.syncs, "Sync")
+	if !ok {
+		return
+	}
+	if sync.Disabled {
+		writeError(w, http.StatusBadRequest, "Sync is disabled", nil)
+		return
+	}
+
+	now := time.Now().UTC()
+	run := &SyncRun{
+		ID:           s.allocateID(),
+		SyncID:       *sync.ID,
+		Status:       "processing",
+		FullResync:   body.FullResync,
+		CreatedAt:    now,
+		StartedAt:    now,
+		PlannedRows:  RowCounts{AddedCount: 10},
+		QuerySize:    10,
+		pendingReads: s.runOutcome.polls,
+		outcome:      s.runOutcome,
+	}
+	run.advance()
+	s.syncRuns[*sync.ID] = append(s.syncRuns[*sync.ID], run)
+	sync.Status = run.Status
+
+	writeJSON(w, http.StatusOK, map[string]string{"id": strconv.Itoa(run.ID)})
+}
+
+// advance finishes the run with its outcome once it has no pending reads
+// left.
+func (run *SyncRun) advance() {
+	if run.Status != "processing" || run.pendingReads > 0 {
+		return
+	}
+	run.Status = run.outcome.status
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.CompletionRatio = 1
+	if run.Status == "failed" {
+		run.FailedRows = run.PlannedRows
+	} else {
+		run.SuccessfulRows = run.PlannedRows
+	}
+	if run.outcome.message != "" {
+		message := run.outcome.message
+		run.Error = &message
+	}
+}
+
+// listSyncRuns returns one page of the runs of a sync matching the runId,
+// after and before query parameters, most recent first. Each read brings a
+// processing run closer to finishing.
+func (s *Server) listSyncRuns(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sync, ok := lookup(w, r, s.syncs, "Sync")
+	if !ok {
+		return
+	}
+
+	limit, offset, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	runID, _ := strconv.Atoi(query.Get("runId"))
+	var after, before time.Time
+	for name, bound := range map[string]*time.Time{"after": &after, "before": &before} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Validation failed", map[string]interface{}{name: "must be an RFC 3339 timestamp"})
+			return
+		}
+		*bound = t
+	}
+
+	// Runs are listed most recent first.
+	runs := s.syncRuns[*sync.ID]
+	matches := make([]*SyncRun, 0, len(runs))
+	for i := len(runs) - 1; i >= 0; i-- {
+		run := runs[i]
+		if runID != 0 && run.ID != runID {
+			continue
+		}
+		if !after.IsZero() && run.CreatedAt.Before(after) {
+			continue
+		}
+		if !before.IsZero() && !run.CreatedAt.Before(before) {
+			continue
+		}
+		matches = append(matches, run)
+	}
+
+	data := make([]*SyncRun, 0, limit)
+	for i := offset; i < len(matches) && len(data) < limit; i++ {
+		matches[i].pendingReads--
+		matches[i].advance()
+		data = append(data, matches[i])
+	}
+	if len(runs) > 0 {
+		sync.Status = runs[len(runs)-1].Status
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":    data,
+		"hasMore": offset+len(data) < len(matches),
+	})
+}
+
+// list writes one page of the objects in store that match, ordered by ID and
+// paginated with the limit and offset query parameters. The caller must hold s.mu.
+func list[T any](w http.ResponseWriter, r *http.Request, store map[int]*T, match func(*T) bool) {
+	limit, offset, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+
+	ids := make([]int, 0, len(store))
+	for id, object := range store {
+		if match(object) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	data := make([]*T, 0, limit)
+	for i := offset; i < len(ids) && len(data) < limit; i++ {
+		data = append(data, store[ids[i]])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":    data,
+		"hasMore": offset+len(data) < len(ids),
+	})
+}
+
+// pagination parses the limit and offset query parameters, writing a 400 if
+// they are invalid.
+func pagination(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	limit, offset := 100, 0
+	query := r.URL.Query()
+
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > 100 {
+			writeError(w, http.StatusBadRequest, "Validation failed", map[string]interface{}{"limit": "must be between 1 and 100"})
+			return 0, 0, false
+		}
+		limit = n
+	}
+	if value := query.Get("offset"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "Validation failed", map[string]interface{}{"offset": "must be a non-negative integer"})
+			return 0, 0, false
+		}
+		offset = n
+	}
+
+	return limit, offset, true
+}
+
+// matchesNameAndSlug applies the exact name and slug query filters.
+func matchesNameAndSlug(r *http.Request, name, slug string) bool {
+	query := r.URL.Query()
+	return (query.Get("name") == "" || query.Get("name") == name) &&
+		(query.Get("slug") == "" || query.Get("slug") == slug)
+}
+
+// lookup finds the object addressed by the {id} path value, writing a 404
+// if it does not exist. The caller must hold s.mu.
+func lookup[T any](w http.ResponseWriter, r *http.Request, store map[int]*T, kind string) (*T, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", map[string]interface{}{"id": "must be an integer"})
+		return nil, false
+	}
+	object, ok := store[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", kind), nil)
+		return nil, false
+	}
+	return object, true
+}
+
+// requireFields returns a validation error for each empty field.
+func requireFields(fields map[string]string) map[string]interface{} {
+	details := make(map[string]interface{})
+	for name, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			details[name] = "is required"
+		}
+	}
+	return details
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error(), nil)
+		return false
+	}
+	return true
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		copied[key] = value
+	}
+	return copied
+}
+
+func orEmpty(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
+
+func writeError(w http.ResponseWriter, status int, message string, details map[string]interface{}) {
+	body := map[string]interface{}{"message": message}
+	if len(details) > 0 {
+		body["details"] = details
+	}
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,86 @@
+package hightouch
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limiter bounds the rate and the concurrency of the requests sent by a
+// Client, which every resource shares when Terraform applies in parallel.
+// Each attempt of a retried request counts as a request. The zero value does
+// not limit anything.
+type limiter struct {
+	// bucket is a token bucket refilled at the allowed rate, so that requests
+	// may burst up to its size and are then spaced evenly; nil means no limit.
+	bucket *rate.Limiter
+	// slots holds a value for every request in flight; nil means no limit.
+	slots chan struct{}
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests per second on
+// average. Up to burst requests, at least one, may be sent at once after a
+// quiet period. Zero requestsPerSecond, the default, means no limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter.bucket = nil
+		if requestsPerSecond > 0 {
+			c.limiter.bucket = rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+		}
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests the client has in
+// flight at once. Zero, the default, means no limit.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *Client) {
+		c.limiter.slots = nil
+		if n > 0 {
+			c.limiter.slots = make(chan struct{}, n)
+		}
+	}
+}
+
+// acquire waits until a request may be sent, or ctx is done. The returned
+// release function must be called once the request has finished.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = func() { <-l.slots }
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// wait takes a token from the bucket, sleeping until one is available. If
+// ctx is done first, the token is given back.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.bucket == nil {
+		return nil
+	}
+
+	reservation := l.bucket.Reserve()
+	delay := reservation.Delay()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
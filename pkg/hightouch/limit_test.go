@@ -0,0 +1,149 @@
+package hightouch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// newSlowServer returns a server that holds every request for delay and
+// records the highest number of requests it had in flight at once.
+func newSlowServer(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(delay)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &maxInFlight
+}
+
+// sendConcurrently sends n GET requests from n goroutines at once and
+// returns how long they took.
+func sendConcurrently(t *testing.T, client *Client, n int) time.Duration {
+	t.Helper()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("makeRequest() error = %v", err)
+	}
+	return time.Since(start)
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	server, maxInFlight := newSlowServer(t, 20*time.Millisecond)
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)), WithMaxConcurrentRequests(3))
+
+	sendConcurrently(t, client, 20)
+	if got := maxInFlight.Load(); got != 3 {
+		t.Errorf("server saw %d concurrent requests, want 3", got)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	server, _ := newSlowServer(t, 0)
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)), WithRateLimit(50, 1))
+
+	// The first request goes out at once and the other 10 are spaced 20ms apart.
+	elapsed := sendConcurrently(t, client, 11)
+	if elapsed < 190*time.Millisecond {
+		t.Errorf("11 requests at 50 per second took %s, want at least 200ms", elapsed)
+	}
+}
+
+func TestRateLimitBurst(t *testing.T) {
+	server, _ := newSlowServer(t, 0)
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)), WithRateLimit(10, 5))
+
+	// A burst of 5 requests goes out at once, and the sixth waits for the
+	// bucket to refill.
+	if elapsed := sendConcurrently(t, client, 5); elapsed > 80*time.Millisecond {
+		t.Errorf("a burst of 5 requests took %s, want no wait", elapsed)
+	}
+	if elapsed := sendConcurrently(t, client, 1); elapsed < 80*time.Millisecond {
+		t.Errorf("a request after the burst took %s, want about 100ms", elapsed)
+	}
+}
+
+func TestUnlimitedClient(t *testing.T) {
+	server, maxInFlight := newSlowServer(t, 50*time.Millisecond)
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)))
+
+	sendConcurrently(t, client, 10)
+	if got := maxInFlight.Load(); got < 2 {
+		t.Errorf("server saw %d concurrent requests, want requests in parallel", got)
+	}
+}
+
+func TestLimiterHonoursContext(t *testing.T) {
+	l := &limiter{slots: make(chan struct{}, 1)}
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	// The only slot is taken, so a second request waits until its deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	release()
+	if _, err := l.acquire(context.Background()); err != nil {
+		t.Errorf("acquire() after release error = %v", err)
+	}
+}
+
+func TestLimiterGivesBackCancelledReservation(t *testing.T) {
+	l := &limiter{bucket: rate.NewLimiter(10, 1)}
+	if _, err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	// The second request would wait 100ms; cancelling it frees its reservation.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	start := time.Now()
+	if _, err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	if waited := time.Since(start); waited > 150*time.Millisecond {
+		t.Errorf("acquire() after a cancelled reservation waited %s, want at most 100ms", waited)
+	}
+}
@@ -0,0 +1,117 @@
+package hightouch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// listPageSize is the number of objects requested per page by the List methods.
+const listPageSize = 100
+
+// ListOptions filters the objects returned by the List methods. Filters are
+// applied by the API and match exactly; zero values match every object.
+type ListOptions struct {
+	Name string
+	Slug string
+}
+
+func (o ListOptions) query() url.Values {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Slug != "" {
+		query.Set("slug", o.Slug)
+	}
+	return query
+}
+
+// listPage is the envelope returned by the paginated list endpoints.
+type listPage[T any] struct {
+	Data    []T  `json:"data"`
+	HasMore bool `json:"hasMore"`
+}
+
+// listAll fetches every page of the list endpoint at path.
+func listAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	all := []T{}
+	for offset := 0; ; {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("limit", strconv.Itoa(listPageSize))
+		pageQuery.Set("offset", strconv.Itoa(offset))
+
+		respBody, err := c.makeRequest(ctx, "GET", path+"?"+pageQuery.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page listPage[T]
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s list response: %w", path, err)
+		}
+
+		all = append(all, page.Data...)
+		if !page.HasMore || len(page.Data) == 0 {
+			return all, nil
+		}
+		offset += len(page.Data)
+	}
+}
+
+// LookupError is returned by the Find methods when a name or slug does not
+// identify exactly one object.
+type LookupError struct {
+	// Kind is the kind of object looked up, such as "sync".
+	Kind string
+	// Field is the attribute used for the lookup, "name" or "slug".
+	Field string
+	// Value is the name or slug that was looked up.
+	Value string
+	// IDs holds the IDs of every matching object; empty if nothing matched.
+	IDs []int
+}
+
+func (e *LookupError) Error() string {
+	if len(e.IDs) == 0 {
+		return fmt.Sprintf("no %s with %s %q was found", e.Kind, e.Field, e.Value)
+	}
+
+	ids := make([]string, 0, len(e.IDs))
+	for _, id := range e.IDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return fmt.Sprintf("%s %q matches %d %ss (IDs %s); look it up by id or slug instead",
+		e.Field, e.Value, len(e.IDs), e.Kind, strings.Join(ids, ", "))
+}
+
+// findOne returns the single object in objects whose name or slug matches
+// opts exactly. Slugs are unique within a workspace, names are not.
+func findOne[T any](kind string, opts ListOptions, objects []T, identify func(T) (id int, name, slug string)) (*T, error) {
+	field, value := "slug", opts.Slug
+	if value == "" {
+		field, value = "name", opts.Name
+	}
+
+	var match *T
+	lookupErr := &LookupError{Kind: kind, Field: field, Value: value}
+	for i := range objects {
+		id, name, slug := identify(objects[i])
+		if (opts.Slug != "" && slug != opts.Slug) || (opts.Name != "" && name != opts.Name) {
+			continue
+		}
+		match = &objects[i]
+		lookupErr.IDs = append(lookupErr.IDs, id)
+	}
+
+	if len(lookupErr.IDs) != 1 {
+		return nil, lookupErr
+	}
+	return match, nil
+}
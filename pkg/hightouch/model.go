@@ -1,6 +1,7 @@
 package hightouch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -23,12 +24,14 @@ type HightouchModel struct {
 
 // GetHightouchModel retrieves a specific model by its ID.
 func (c *Client) GetHightouchModel(
+	ctx context.Context,
 	modelID int,
 ) (*HightouchModel, error) {
 
 	var model HightouchModel
 
 	respBody, err := c.makeRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf("/models/%d", modelID),
 		nil,
@@ -46,6 +49,7 @@ func (c *Client) GetHightouchModel(
 
 // CreateHightouchModel creates a new model in Hightouch.
 func (c *Client) CreateHightouchModel(
+	ctx context.Context,
 	name string,
 	slug string,
 	sourceID int,
@@ -71,6 +75,7 @@ func (c *Client) CreateHightouchModel(
 
 	var model HightouchModel
 	respBody, err := c.makeRequest(
+		ctx,
 		"POST",
 		"/models",
 		requestBody,
@@ -90,6 +95,7 @@ func (c *Client) CreateHightouchModel(
 // UpdateHightouchModel updates a specific model.
 // The name, SQL, primary key, description, and tags can be updated.
 func (c *Client) UpdateHightouchModel(
+	ctx context.Context,
 	modelID int,
 	name string,
 	sql string,
@@ -107,6 +113,7 @@ func (c *Client) UpdateHightouchModel(
 
 	var model HightouchModel
 	respBody, err := c.makeRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf("/models/%d", modelID),
 		requestBody,
@@ -122,3 +129,37 @@ func (c *Client) UpdateHightouchModel(
 
 	return &model, nil
 }
+
+// DeleteHightouchModel deletes a specific model.
+// Deleting a model that no longer exists is not an error. If the model still has
+// dependent syncs, a *DependentObjectsError is returned.
+func (c *Client) DeleteHightouchModel(
+	ctx context.Context,
+	modelID int,
+) error {
+	return c.deleteObject(ctx, "model", modelID, "syncs", fmt.Sprintf("/models/%d", modelID))
+}
+
+// ListHightouchModels retrieves all models matching opts, following pagination.
+func (c *Client) ListHightouchModels(
+	ctx context.Context,
+	opts ListOptions,
+) ([]HightouchModel, error) {
+	return listAll[HightouchModel](ctx, c, "/models", opts.query())
+}
+
+// FindHightouchModel retrieves the single model whose slug or name matches
+// opts exactly. A *LookupError is returned if no model, or more than one,
+// matches.
+func (c *Client) FindHightouchModel(
+	ctx context.Context,
+	opts ListOptions,
+) (*HightouchModel, error) {
+	models, err := c.ListHightouchModels(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return findOne("model", opts, models, func(m HightouchModel) (int, string, string) {
+		return *m.ID, m.Name, m.Slug
+	})
+}
@@ -0,0 +1,137 @@
+package hightouch
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// redactedValue replaces sensitive values in logged payloads.
+const redactedValue = "***REDACTED***"
+
+// defaultSensitiveKeys are always masked in logged payloads and headers,
+// regardless of which resource schemas are registered with the client.
+var defaultSensitiveKeys = []string{
+	"password",
+	"api_key",
+	"authorization",
+	"secret",
+	"client_secret",
+	"token",
+	"access_token",
+	"refresh_token",
+	"private_key",
+	"credentials",
+}
+
+// redactor masks the values of sensitive keys in request and response
+// payloads before they are logged. Keys are compared after normalisation, so
+// "api_key", "apiKey" and "API-Key" are treated as the same key.
+type redactor struct {
+	keys map[string]struct{}
+}
+
+func newRedactor(keys ...string) *redactor {
+	r := &redactor{keys: make(map[string]struct{})}
+	r.add(defaultSensitiveKeys...)
+	r.add(keys...)
+	return r
+}
+
+func (r *redactor) add(keys ...string) {
+	for _, key := range keys {
+		if key = normalizeKey(key); key != "" {
+			r.keys[key] = struct{}{}
+		}
+	}
+}
+
+func (r *redactor) isSensitive(key string) bool {
+	_, ok := r.keys[normalizeKey(key)]
+	return ok
+}
+
+// JSON returns body with the values of all sensitive keys masked, at any
+// depth. Bodies that are not valid JSON are returned unchanged.
+func (r *redactor) JSON(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(r.value(data))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// Header returns a copy of header with the values of sensitive headers masked.
+func (r *redactor) Header(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for key, values := range header {
+		if r.isSensitive(key) {
+			redacted[key] = redactedValue
+			continue
+		}
+		redacted[key] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+func (r *redactor) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if r.isSensitive(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = r.value(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = r.value(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// without returns a copy of v with the entries of sensitive keys removed, at
+// any depth.
+func (r *redactor) without(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		kept := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			if !r.isSensitive(key) {
+				kept[key] = r.without(child)
+			}
+		}
+		return kept
+	case []interface{}:
+		kept := make([]interface{}, len(v))
+		for i, child := range v {
+			kept[i] = r.without(child)
+		}
+		return kept
+	default:
+		return v
+	}
+}
+
+// normalizeKey lowercases key and strips separators so that snake_case
+// schema attribute names match camelCase API fields.
+func normalizeKey(key string) string {
+	key = strings.ToLower(key)
+	key = strings.ReplaceAll(key, "_", "")
+	key = strings.ReplaceAll(key, "-", "")
+	return key
+}
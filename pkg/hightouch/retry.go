@@ -0,0 +1,177 @@
+package hightouch
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default total number of attempts per request.
+	DefaultRetryMaxAttempts = 5
+	// DefaultRetryMaxElapsedTime is the default upper bound on the time spent
+	// retrying a single request, including waits between attempts.
+	DefaultRetryMaxElapsedTime = 2 * time.Minute
+
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy controls how the client retries throttled and transiently
+// failing requests.
+//
+// Idempotent requests (GET, HEAD, PUT, DELETE, OPTIONS) are retried on 429,
+// 502, 503 and 504 responses and on transport errors such as connection
+// resets. Writes (POST, PATCH) are only retried when the API throttled them
+// with a 429, since the request was rejected before it was processed.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as 1.
+	MaxAttempts int
+	// MaxElapsedTime bounds the total time spent on a request, including
+	// waits between attempts. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// MinBackoff is the wait before the first retry. It doubles on each
+	// subsequent retry, up to MaxBackoff, and is jittered.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed backoff. A Retry-After header sent by the
+	// API takes precedence over the computed backoff.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    DefaultRetryMaxAttempts,
+		MaxElapsedTime: DefaultRetryMaxElapsedTime,
+		MinBackoff:     defaultRetryMinBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+	}
+}
+
+// do calls attempt until it succeeds, fails permanently, or the policy's
+// attempt or time budget is exhausted. The last response or error is returned.
+func (p RetryPolicy) do(
+	ctx context.Context,
+	method string,
+	attempt func() (*response, error),
+) (*response, error) {
+	start := time.Now()
+	maxAttempts := max(p.MaxAttempts, 1)
+
+	for n := 1; ; n++ {
+		resp, err := attempt()
+		if n >= maxAttempts || ctx.Err() != nil || !shouldRetry(method, resp, err) {
+			return resp, err
+		}
+
+		wait := p.backoff(n)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				wait = retryAfter
+			}
+		}
+		if p.MaxElapsedTime > 0 && time.Since(start)+wait > p.MaxElapsedTime {
+			return resp, err
+		}
+
+		fields := map[string]interface{}{
+			"attempt":      n,
+			"max_attempts": maxAttempts,
+			"wait_ms":      wait.Milliseconds(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["http_status"] = resp.StatusCode
+		}
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Retrying Hightouch API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the jittered wait before retry number n (starting at 1).
+func (p RetryPolicy) backoff(n int) time.Duration {
+	d := p.MinBackoff
+	if d <= 0 {
+		return 0
+	}
+	for i := 1; i < n && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	// Wait at least half the backoff so concurrent callers spread out without
+	// retrying immediately.
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// shouldRetry reports whether a request with the given method and outcome
+// may be safely retried.
+func shouldRetry(method string, resp *response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
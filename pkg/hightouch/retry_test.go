@@ -0,0 +1,163 @@
+package hightouch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy retries quickly so tests do not sleep for long.
+func testRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		MaxElapsedTime: 5 * time.Second,
+		MinBackoff:     time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+}
+
+// newFlakyServer returns a server that answers the first failures requests
+// with status and every later request with 200.
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"try again"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1,"name":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestMakeRequestRetries(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		status      int
+		failures    int32
+		maxAttempts int
+		wantCalls   int32
+		wantErr     bool
+	}{
+		{name: "GET retried on 503", method: http.MethodGet, status: http.StatusServiceUnavailable, failures: 2, maxAttempts: 5, wantCalls: 3},
+		{name: "GET retried on 502", method: http.MethodGet, status: http.StatusBadGateway, failures: 1, maxAttempts: 5, wantCalls: 2},
+		{name: "POST retried on 429", method: http.MethodPost, status: http.StatusTooManyRequests, failures: 2, maxAttempts: 5, wantCalls: 3},
+		{name: "POST not retried on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, failures: 1, maxAttempts: 5, wantCalls: 1, wantErr: true},
+		{name: "PATCH not retried on 502", method: http.MethodPatch, status: http.StatusBadGateway, failures: 1, maxAttempts: 5, wantCalls: 1, wantErr: true},
+		{name: "GET not retried on 400", method: http.MethodGet, status: http.StatusBadRequest, failures: 1, maxAttempts: 5, wantCalls: 1, wantErr: true},
+		{name: "gives up after max attempts", method: http.MethodGet, status: http.StatusTooManyRequests, failures: 10, maxAttempts: 3, wantCalls: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newFlakyServer(t, tt.failures, tt.status, nil)
+			client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(tt.maxAttempts)))
+
+			_, err := client.makeRequest(context.Background(), tt.method, "/syncs", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("makeRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("server received %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestMakeRequestHonoursRetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+
+	policy := testRetryPolicy(3)
+	client := NewClient("key", server.URL, WithRetryPolicy(policy))
+
+	start := time.Now()
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/syncs", nil); err != nil {
+		t.Fatalf("makeRequest() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the 1s requested by Retry-After", elapsed)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server received %d calls, want 2", got)
+	}
+}
+
+func TestMakeRequestStopsWhenRetryAfterExceedsBudget(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"60"}})
+
+	policy := testRetryPolicy(3)
+	policy.MaxElapsedTime = time.Second
+	client := NewClient("key", server.URL, WithRetryPolicy(policy))
+
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/syncs", nil); err == nil {
+		t.Fatal("makeRequest() succeeded, want the throttling error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server received %d calls, want 1", got)
+	}
+}
+
+func TestMakeRequestCancelledWhileWaiting(t *testing.T) {
+	server, _ := newFlakyServer(t, 10, http.StatusServiceUnavailable, http.Header{"Retry-After": []string{"30"}})
+
+	policy := testRetryPolicy(5)
+	policy.MaxElapsedTime = time.Minute
+	client := NewClient("key", server.URL, WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.makeRequest(ctx, http.MethodGet, "/syncs", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("makeRequest() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", wantOK: false},
+		{value: "3", want: 3 * time.Second, wantOK: true},
+		{value: "-1", wantOK: false},
+		{value: "Mon, 01 Jan 2024 12:00:10 GMT", want: 10 * time.Second, wantOK: true},
+		{value: "Mon, 01 Jan 2024 11:59:00 GMT", want: 0, wantOK: true},
+		{value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, %t; want %s, %t", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for n := 1; n <= 10; n++ {
+		ceiling := min(policy.MinBackoff<<(n-1), policy.MaxBackoff)
+		got := policy.backoff(n)
+		if got < ceiling/2 || got > ceiling {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", n, got, ceiling/2, ceiling)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package hightouch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -17,14 +18,16 @@ type HightouchSource struct {
 	Configuration map[string]interface{} `json:"configuration"`
 }
 
-// GetSnowflakeSource GetHightouchSource retrieves a specific source by its ID.
-func (c *Client) GetSnowflakeSource(
+// GetHightouchSource retrieves a specific source by its ID.
+func (c *Client) GetHightouchSource(
+	ctx context.Context,
 	sourceID int,
 ) (*HightouchSource, error) {
 
 	var source HightouchSource
 
 	respBody, err := c.makeRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf("/sources/%d", sourceID),
 		nil,
@@ -41,6 +44,7 @@ func (c *Client) GetSnowflakeSource(
 }
 
 func (c *Client) CreateHightouchSource(
+	ctx context.Context,
 	name string,
 	slug string,
 	sourceType string,
@@ -60,6 +64,7 @@ func (c *Client) CreateHightouchSource(
 
 	var source HightouchSource
 	respBody, err := c.makeRequest(
+		ctx,
 		"POST",
 		"/sources",
 		requestBody,
@@ -79,6 +84,7 @@ func (c *Client) CreateHightouchSource(
 // UpdateHightouchSource updates a specific source.
 // The `updates` map can contain any of the mutable source fields, e.g., "name", "configuration".
 func (c *Client) UpdateHightouchSource(
+	ctx context.Context,
 	sourceID int,
 	name string,
 	configuration map[string]interface{},
@@ -93,6 +99,7 @@ func (c *Client) UpdateHightouchSource(
 
 	var source HightouchSource
 	respBody, err := c.makeRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf("/sources/%d", sourceID),
 		requestBody,
@@ -108,3 +115,93 @@ func (c *Client) UpdateHightouchSource(
 
 	return &source, nil
 }
+
+// DeleteHightouchSource deletes a specific source.
+// Deleting a source that no longer exists is not an error. If the source still has
+// dependent models, a *DependentObjectsError is returned.
+func (c *Client) DeleteHightouchSource(
+	ctx context.Context,
+	sourceID int,
+) error {
+	return c.deleteObject(ctx, "source", sourceID, "models", fmt.Sprintf("/sources/%d", sourceID))
+}
+
+// ListHightouchSources retrieves all sources matching opts, following pagination.
+func (c *Client) ListHightouchSources(
+	ctx context.Context,
+	opts ListOptions,
+) ([]HightouchSource, error) {
+	return listAll[HightouchSource](ctx, c, "/sources", opts.query())
+}
+
+// FindHightouchSource retrieves the single source whose slug or name matches
+// opts exactly. A *LookupError is returned if no source, or more than one,
+// matches.
+func (c *Client) FindHightouchSource(
+	ctx context.Context,
+	opts ListOptions,
+) (*HightouchSource, error) {
+	sources, err := c.ListHightouchSources(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return findOne("source", opts, sources, func(s HightouchSource) (int, string, string) {
+		return *s.ID, s.Name, s.Slug
+	})
+}
+
+// SourceTypeError is returned by the OfType methods when a source exists but
+// is not of the requested type.
+type SourceTypeError struct {
+	// ID is the ID of the source.
+	ID int
+	// Type is the type of the source, such as "bigquery".
+	Type string
+	// Want is the requested type, such as "snowflake".
+	Want string
+}
+
+func (e *SourceTypeError) Error() string {
+	return fmt.Sprintf("source %d is a %s source, not a %s source", e.ID, e.Type, e.Want)
+}
+
+// GetHightouchSourceOfType retrieves a specific source by its ID, returning a
+// *SourceTypeError if it is not of sourceType, such as "snowflake".
+func (c *Client) GetHightouchSourceOfType(
+	ctx context.Context,
+	sourceType string,
+	sourceID int,
+) (*HightouchSource, error) {
+	source, err := c.GetHightouchSource(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source.Type != sourceType {
+		return nil, &SourceTypeError{ID: sourceID, Type: source.Type, Want: sourceType}
+	}
+	return source, nil
+}
+
+// FindHightouchSourceOfType is FindHightouchSource restricted to sources of
+// sourceType, such as "snowflake". Sources of other types never match, so
+// they cannot make a name ambiguous.
+func (c *Client) FindHightouchSourceOfType(
+	ctx context.Context,
+	sourceType string,
+	opts ListOptions,
+) (*HightouchSource, error) {
+	sources, err := c.ListHightouchSources(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ofType := make([]HightouchSource, 0, len(sources))
+	for _, source := range sources {
+		if source.Type == sourceType {
+			ofType = append(ofType, source)
+		}
+	}
+	return findOne(sourceType+" source", opts, ofType, func(s HightouchSource) (int, string, string) {
+		return *s.ID, s.Name, s.Slug
+	})
+}
@@ -0,0 +1,302 @@
+package hightouch
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SnowflakeConfiguration is the typed form of a Snowflake source's
+// configuration object.
+type SnowflakeConfiguration struct {
+	Account   string
+	Username  string
+	Database  string
+	Warehouse string
+	// Port is nil when the source uses the default port.
+	Port *int64
+	// AuthMethod is empty for sources created before key-pair and OAuth
+	// authentication were supported, which use a password.
+	AuthMethod    string
+	OAuthClientID *string
+	Role          *string
+	Schema        *string
+}
+
+// ParseSnowflakeConfiguration decodes the configuration object of a Snowflake
+// source returned by the API. It returns a *ConfigurationError listing every
+// required key that is missing and every key with a value of the wrong type.
+// Secrets are not decoded, since the API omits or masks them.
+func ParseSnowflakeConfiguration(configuration map[string]interface{}) (SnowflakeConfiguration, error) {
+	d := configurationDecoder{sourceType: "snowflake", configuration: configuration}
+	parsed := SnowflakeConfiguration{
+		Account:       d.requiredString("account"),
+		Username:      d.requiredString("username"),
+		Database:      d.requiredString("database"),
+		Warehouse:     d.requiredString("warehouse"),
+		Port:          d.optionalInt("port"),
+		OAuthClientID: d.optionalString("oauth_client_id"),
+		Role:          d.optionalString("role"),
+		Schema:        d.optionalString("schema"),
+	}
+	if authMethod := d.optionalString("auth_method"); authMethod != nil {
+		parsed.AuthMethod = *authMethod
+	}
+	if err := d.err(); err != nil {
+		return SnowflakeConfiguration{}, err
+	}
+	return parsed, nil
+}
+
+// BigQueryConfiguration is the typed form of a BigQuery source's
+// configuration object.
+type BigQueryConfiguration struct {
+	Project string
+	// Location is nil when datasets are in the default multi-region.
+	Location *string
+	// AuthMethod is empty for sources that authenticate with a service
+	// account key.
+	AuthMethod               string
+	WorkloadIdentityProvider *string
+	ServiceAccountEmail      *string
+}
+
+// ParseBigQueryConfiguration decodes the configuration object of a BigQuery
+// source returned by the API. It returns a *ConfigurationError like
+// ParseSnowflakeConfiguration.
+func ParseBigQueryConfiguration(configuration map[string]interface{}) (BigQueryConfiguration, error) {
+	d := configurationDecoder{sourceType: "bigquery", configuration: configuration}
+	parsed := BigQueryConfiguration{
+		Project:                  d.requiredString("project"),
+		Location:                 d.optionalString("location"),
+		WorkloadIdentityProvider: d.optionalString("workload_identity_provider"),
+		ServiceAccountEmail:      d.optionalString("service_account_email"),
+	}
+	if authMethod := d.optionalString("auth_method"); authMethod != nil {
+		parsed.AuthMethod = *authMethod
+	}
+	if err := d.err(); err != nil {
+		return BigQueryConfiguration{}, err
+	}
+	return parsed, nil
+}
+
+// DatabricksConfiguration is the typed form of a Databricks source's
+// configuration object.
+type DatabricksConfiguration struct {
+	Host     string
+	HTTPPath string
+	Catalog  *string
+	Schema   *string
+	// AuthMethod is empty for sources that authenticate with a personal
+	// access token.
+	AuthMethod    string
+	OAuthClientID *string
+}
+
+// ParseDatabricksConfiguration decodes the configuration object of a
+// Databricks source returned by the API. It returns a *ConfigurationError like
+// ParseSnowflakeConfiguration.
+func ParseDatabricksConfiguration(configuration map[string]interface{}) (DatabricksConfiguration, error) {
+	d := configurationDecoder{sourceType: "databricks", configuration: configuration}
+	parsed := DatabricksConfiguration{
+		Host:          d.requiredString("host"),
+		HTTPPath:      d.requiredString("http_path"),
+		Catalog:       d.optionalString("catalog"),
+		Schema:        d.optionalString("schema"),
+		OAuthClientID: d.optionalString("oauth_client_id"),
+	}
+	if authMethod := d.optionalString("auth_method"); authMethod != nil {
+		parsed.AuthMethod = *authMethod
+	}
+	if err := d.err(); err != nil {
+		return DatabricksConfiguration{}, err
+	}
+	return parsed, nil
+}
+
+// DatabaseConfiguration is the typed form of the configuration object of a
+// source that connects to a database server, such as Postgres or Redshift.
+type DatabaseConfiguration struct {
+	Host     string
+	Database string
+	User     string
+	// Port is nil when the source uses the default port of its type.
+	Port    *int64
+	SSLMode *string
+	// SSHTunnel is nil when the source connects to the database directly.
+	SSHTunnel *SSHTunnelConfiguration
+}
+
+// SSHTunnelConfiguration is the typed form of the ssh_tunnel object of a
+// source that connects to its database through a bastion host.
+type SSHTunnelConfiguration struct {
+	Host string
+	User string
+	Port *int64
+}
+
+// ParseDatabaseConfiguration decodes the configuration object of a source of
+// sourceType, such as "postgres" or "redshift", returned by the API. It
+// returns a *ConfigurationError like ParseSnowflakeConfiguration.
+func ParseDatabaseConfiguration(sourceType string, configuration map[string]interface{}) (DatabaseConfiguration, error) {
+	d := configurationDecoder{sourceType: sourceType, configuration: configuration}
+	parsed := DatabaseConfiguration{
+		Host:     d.requiredString("host"),
+		Database: d.requiredString("database"),
+		User:     d.requiredString("user"),
+		Port:     d.optionalInt("port"),
+		SSLMode:  d.optionalString("ssl_mode"),
+	}
+	if tunnel := d.object("ssh_tunnel"); tunnel != nil {
+		parsed.SSHTunnel = &SSHTunnelConfiguration{
+			Host: tunnel.requiredString("host"),
+			User: tunnel.requiredString("user"),
+			Port: tunnel.optionalInt("port"),
+		}
+	}
+	if err := d.err(); err != nil {
+		return DatabaseConfiguration{}, err
+	}
+	return parsed, nil
+}
+
+// ConfigurationError reports a source configuration returned by the API that
+// does not have the shape its type requires.
+type ConfigurationError struct {
+	// SourceType is the type of the source, such as "snowflake".
+	SourceType string
+	// Problems describes each invalid key, keyed by the configuration key.
+	Problems map[string]string
+}
+
+func (e *ConfigurationError) Error() string {
+	keys := make([]string, 0, len(e.Problems))
+	for key := range e.Problems {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	problems := make([]string, 0, len(keys))
+	for _, key := range keys {
+		problems = append(problems, fmt.Sprintf("%q %s", key, e.Problems[key]))
+	}
+	return fmt.Sprintf("invalid %s source configuration: %s", e.SourceType, strings.Join(problems, "; "))
+}
+
+// configurationDecoder reads typed values out of a configuration object,
+// collecting every problem instead of stopping at the first one. JSON nulls
+// are treated as missing keys.
+type configurationDecoder struct {
+	sourceType    string
+	configuration map[string]interface{}
+	problems      map[string]string
+
+	// parent and prefix are set for decoders of nested objects, which report
+	// their problems to parent with keys prefixed by the object's key.
+	parent *configurationDecoder
+	prefix string
+}
+
+func (d *configurationDecoder) fail(key, format string, args ...interface{}) {
+	if d.parent != nil {
+		d.parent.fail(d.prefix+key, format, args...)
+		return
+	}
+	if d.problems == nil {
+		d.problems = make(map[string]string)
+	}
+	d.problems[key] = fmt.Sprintf(format, args...)
+}
+
+func (d *configurationDecoder) requiredString(key string) string {
+	if value, ok := d.configuration[key]; !ok || value == nil {
+		d.fail(key, "is missing")
+		return ""
+	}
+	if value := d.optionalString(key); value != nil {
+		return *value
+	}
+	return ""
+}
+
+// object returns a decoder for the nested object at key, or nil if the key
+// is missing or is not an object.
+func (d *configurationDecoder) object(key string) *configurationDecoder {
+	value, ok := d.configuration[key]
+	if !ok || value == nil {
+		return nil
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		d.fail(key, "must be an object, got %T", value)
+		return nil
+	}
+	return &configurationDecoder{sourceType: d.sourceType, configuration: object, parent: d, prefix: key + "."}
+}
+
+func (d *configurationDecoder) optionalString(key string) *string {
+	value, ok := d.configuration[key]
+	if !ok || value == nil {
+		return nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		d.fail(key, "must be a string, got %T", value)
+		return nil
+	}
+	return &s
+}
+
+// optionalInt accepts JSON numbers and numeric strings, since the API returns
+// ports and similar settings in either form.
+func (d *configurationDecoder) optionalInt(key string) *int64 {
+	value, ok := d.configuration[key]
+	if !ok || value == nil {
+		return nil
+	}
+
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			d.fail(key, "must be an integer, got %q", v.String())
+			return nil
+		}
+		return &n
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			d.fail(key, "must be an integer, got %q", v)
+			return nil
+		}
+		return &n
+	default:
+		d.fail(key, "must be an integer, got %T", value)
+		return nil
+	}
+
+	if f != math.Trunc(f) || math.Abs(f) > 1<<53 {
+		d.fail(key, "must be an integer, got %v", f)
+		return nil
+	}
+	n := int64(f)
+	return &n
+}
+
+func (d *configurationDecoder) err() error {
+	if len(d.problems) == 0 {
+		return nil
+	}
+	return &ConfigurationError{SourceType: d.sourceType, Problems: d.problems}
+}
@@ -1,8 +1,10 @@
 package hightouch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -23,12 +25,14 @@ type HightouchSync struct {
 
 // GetHightouchSync retrieves a specific sync by its ID.
 func (c *Client) GetHightouchSync(
+	ctx context.Context,
 	syncID int,
 ) (*HightouchSync, error) {
 
 	var sync HightouchSync
 
 	respBody, err := c.makeRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf("/syncs/%d", syncID),
 		nil,
@@ -46,6 +50,7 @@ func (c *Client) GetHightouchSync(
 
 // CreateHightouchSync creates a new sync in Hightouch.
 func (c *Client) CreateHightouchSync(
+	ctx context.Context,
 	name string,
 	slug string,
 	sourceID int,
@@ -74,6 +79,7 @@ func (c *Client) CreateHightouchSync(
 
 	var sync HightouchSync
 	respBody, err := c.makeRequest(
+		ctx,
 		"POST",
 		"/syncs",
 		requestBody,
@@ -93,6 +99,7 @@ func (c *Client) CreateHightouchSync(
 // UpdateHightouchSync updates a specific sync.
 // The name, configuration, schedule, and disabled status can be updated.
 func (c *Client) UpdateHightouchSync(
+	ctx context.Context,
 	syncID int,
 	name string,
 	configuration map[string]interface{},
@@ -113,6 +120,7 @@ func (c *Client) UpdateHightouchSync(
 
 	var sync HightouchSync
 	respBody, err := c.makeRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf("/syncs/%d", syncID),
 		requestBody,
@@ -128,3 +136,47 @@ func (c *Client) UpdateHightouchSync(
 
 	return &sync, nil
 }
+
+// DeleteHightouchSync deletes a specific sync.
+// Deleting a sync that no longer exists is not an error.
+func (c *Client) DeleteHightouchSync(
+	ctx context.Context,
+	syncID int,
+) error {
+	return c.deleteObject(ctx, "sync", syncID, "", fmt.Sprintf("/syncs/%d", syncID))
+}
+
+// ListSyncsOptions filters the syncs returned by ListHightouchSyncs.
+type ListSyncsOptions struct {
+	ListOptions
+	// ModelID, if set, only matches syncs of that model.
+	ModelID int
+}
+
+// ListHightouchSyncs retrieves all syncs matching opts, following pagination.
+func (c *Client) ListHightouchSyncs(
+	ctx context.Context,
+	opts ListSyncsOptions,
+) ([]HightouchSync, error) {
+	query := opts.query()
+	if opts.ModelID != 0 {
+		query.Set("modelId", strconv.Itoa(opts.ModelID))
+	}
+	return listAll[HightouchSync](ctx, c, "/syncs", query)
+}
+
+// FindHightouchSync retrieves the single sync whose slug or name matches
+// opts exactly. A *LookupError is returned if no sync, or more than one,
+// matches.
+func (c *Client) FindHightouchSync(
+	ctx context.Context,
+	opts ListOptions,
+) (*HightouchSync, error) {
+	syncs, err := c.ListHightouchSyncs(ctx, ListSyncsOptions{ListOptions: opts})
+	if err != nil {
+		return nil, err
+	}
+	return findOne("sync", opts, syncs, func(s HightouchSync) (int, string, string) {
+		return *s.ID, s.Name, s.Slug
+	})
+}
@@ -0,0 +1,137 @@
+package hightouch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Keys of the sync configuration object that SyncConfiguration models with
+// typed fields.
+const (
+	SyncModeKey           = "mode"
+	SyncIdentifierKey     = "externalIdMapping"
+	SyncMappingsKey       = "mappings"
+	SyncCustomMappingsKey = "customMappings"
+	SyncDeleteModeKey     = "deleteMode"
+)
+
+// SyncConfigurationKeys lists the configuration keys managed by the typed
+// fields of SyncConfiguration. Every other key is kept in Extra.
+var SyncConfigurationKeys = []string{
+	SyncModeKey,
+	SyncIdentifierKey,
+	SyncMappingsKey,
+	SyncCustomMappingsKey,
+	SyncDeleteModeKey,
+}
+
+// syncModesByDestinationType lists the sync modes of the destination types
+// whose supported modes are known.
+var syncModesByDestinationType = map[string][]string{
+	"iterable": {"upsert", "update", "insert"},
+}
+
+// SupportedSyncModes returns the sync modes a destination type supports, or
+// nil if they are not known and any mode may be used.
+func SupportedSyncModes(destinationType string) []string {
+	return syncModesByDestinationType[destinationType]
+}
+
+// SyncMapping maps a model column to a field in the destination.
+type SyncMapping struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type,omitempty"`
+}
+
+// SyncConfiguration is the typed form of a sync's configuration object.
+type SyncConfiguration struct {
+	// Mode is how records are written: upsert, update, insert or mirror.
+	Mode string
+	// Identifier matches model rows to existing destination records.
+	Identifier *SyncMapping
+	// Mappings map model columns to standard destination fields.
+	Mappings []SyncMapping
+	// CustomMappings map model columns to custom destination fields.
+	CustomMappings []SyncMapping
+	// DeleteMode is what happens in the destination when a row leaves the model.
+	DeleteMode string
+	// Extra holds every other configuration key, passed through unchanged.
+	Extra map[string]interface{}
+}
+
+// ParseSyncConfiguration splits a sync configuration object returned by the
+// API into its typed fields and the remaining keys.
+func ParseSyncConfiguration(configuration map[string]interface{}) (SyncConfiguration, error) {
+	parsed := SyncConfiguration{Extra: map[string]interface{}{}}
+	for key, value := range configuration {
+		var err error
+		switch key {
+		case SyncModeKey:
+			err = convertJSON(value, &parsed.Mode)
+		case SyncIdentifierKey:
+			err = convertJSON(value, &parsed.Identifier)
+		case SyncMappingsKey:
+			err = convertJSON(value, &parsed.Mappings)
+		case SyncCustomMappingsKey:
+			err = convertJSON(value, &parsed.CustomMappings)
+		case SyncDeleteModeKey:
+			err = convertJSON(value, &parsed.DeleteMode)
+		default:
+			parsed.Extra[key] = value
+		}
+		if err != nil {
+			return SyncConfiguration{}, fmt.Errorf("invalid sync configuration key %q: %w", key, err)
+		}
+	}
+	return parsed, nil
+}
+
+// Map returns the configuration object sent to the API. Unset typed fields
+// are omitted.
+func (c SyncConfiguration) Map() (map[string]interface{}, error) {
+	configuration := make(map[string]interface{}, len(c.Extra)+len(SyncConfigurationKeys))
+	for key, value := range c.Extra {
+		configuration[key] = value
+	}
+
+	typed := map[string]interface{}{}
+	if c.Mode != "" {
+		typed[SyncModeKey] = c.Mode
+	}
+	if c.Identifier != nil {
+		typed[SyncIdentifierKey] = c.Identifier
+	}
+	if len(c.Mappings) > 0 {
+		typed[SyncMappingsKey] = c.Mappings
+	}
+	if len(c.CustomMappings) > 0 {
+		typed[SyncCustomMappingsKey] = c.CustomMappings
+	}
+	if c.DeleteMode != "" {
+		typed[SyncDeleteModeKey] = c.DeleteMode
+	}
+
+	for key, value := range typed {
+		if _, ok := configuration[key]; ok {
+			return nil, fmt.Errorf("sync configuration key %q is set both directly and through its typed field", key)
+		}
+		// Store plain JSON values so the result compares equal to a decoded
+		// API response.
+		var plain interface{}
+		if err := convertJSON(value, &plain); err != nil {
+			return nil, fmt.Errorf("invalid sync configuration key %q: %w", key, err)
+		}
+		configuration[key] = plain
+	}
+	return configuration, nil
+}
+
+// convertJSON converts value into target by round-tripping it through JSON.
+func convertJSON(value interface{}, target interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
@@ -0,0 +1,101 @@
+package hightouch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testSyncConfigurationJSON = `{
+	"mode": "upsert",
+	"object": "contact",
+	"externalIdMapping": {"from": "email", "to": "email", "type": "standard"},
+	"mappings": [
+		{"from": "first_name", "to": "firstname", "type": "standard"},
+		{"from": "plan", "to": "plan_tier", "type": "static"}
+	],
+	"customMappings": [{"from": "ltv", "to": "lifetime_value"}],
+	"deleteMode": "clear",
+	"batchSize": 500
+}`
+
+func TestParseSyncConfiguration(t *testing.T) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(testSyncConfigurationJSON), &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParseSyncConfiguration(raw)
+	if err != nil {
+		t.Fatalf("ParseSyncConfiguration() error = %v", err)
+	}
+
+	want := SyncConfiguration{
+		Mode:       "upsert",
+		Identifier: &SyncMapping{From: "email", To: "email", Type: "standard"},
+		Mappings: []SyncMapping{
+			{From: "first_name", To: "firstname", Type: "standard"},
+			{From: "plan", To: "plan_tier", Type: "static"},
+		},
+		CustomMappings: []SyncMapping{{From: "ltv", To: "lifetime_value"}},
+		DeleteMode:     "clear",
+		Extra:          map[string]interface{}{"object": "contact", "batchSize": float64(500)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSyncConfiguration() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSyncConfigurationRoundTrip(t *testing.T) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(testSyncConfigurationJSON), &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseSyncConfiguration(raw)
+	if err != nil {
+		t.Fatalf("ParseSyncConfiguration() error = %v", err)
+	}
+	got, err := parsed.Map()
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, raw) {
+		t.Errorf("Map() = %v, want the original configuration %v", got, raw)
+	}
+
+	reparsed, err := ParseSyncConfiguration(got)
+	if err != nil {
+		t.Fatalf("ParseSyncConfiguration() error = %v", err)
+	}
+	if !reflect.DeepEqual(reparsed, parsed) {
+		t.Errorf("ParseSyncConfiguration(Map()) = %+v, want %+v", reparsed, parsed)
+	}
+}
+
+func TestSyncConfigurationMapOmitsUnsetFields(t *testing.T) {
+	got, err := SyncConfiguration{Mode: "insert"}.Map()
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if want := map[string]interface{}{"mode": "insert"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+}
+
+func TestSyncConfigurationMapRejectsDuplicateKeys(t *testing.T) {
+	configuration := SyncConfiguration{
+		Mode:  "upsert",
+		Extra: map[string]interface{}{"mode": "mirror"},
+	}
+	if _, err := configuration.Map(); err == nil {
+		t.Error("Map() error = nil, want an error for mode set twice")
+	}
+}
+
+func TestParseSyncConfigurationRejectsInvalidTypes(t *testing.T) {
+	_, err := ParseSyncConfiguration(map[string]interface{}{"mappings": "email"})
+	if err == nil {
+		t.Error("ParseSyncConfiguration() error = nil, want an error for non-list mappings")
+	}
+}
@@ -0,0 +1,266 @@
+package hightouch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SyncRunRows holds per-operation row counts of a sync run.
+type SyncRunRows struct {
+	AddedCount   int `json:"addedCount"`
+	ChangedCount int `json:"changedCount"`
+	RemovedCount int `json:"removedCount"`
+}
+
+// SyncRun is a single run of a sync.
+type SyncRun struct {
+	ID              int         `json:"id"`
+	Status          string      `json:"status"`
+	CreatedAt       time.Time   `json:"createdAt"`
+	StartedAt       *time.Time  `json:"startedAt"`
+	FinishedAt      *time.Time  `json:"finishedAt"`
+	PlannedRows     SyncRunRows `json:"plannedRows"`
+	SuccessfulRows  SyncRunRows `json:"successfulRows"`
+	FailedRows      SyncRunRows `json:"failedRows"`
+	QuerySize       int         `json:"querySize"`
+	CompletionRatio float64     `json:"completionRatio"`
+	Error           *string     `json:"error"`
+}
+
+// syncRunFinishedStatuses are the statuses of runs that will not change anymore.
+var syncRunFinishedStatuses = map[string]bool{
+	"success":     true,
+	"warning":     true,
+	"failed":      true,
+	"cancelled":   true,
+	"interrupted": true,
+	"abandoned":   true,
+}
+
+// Finished reports whether the run has stopped, successfully or not.
+func (r SyncRun) Finished() bool {
+	return syncRunFinishedStatuses[r.Status]
+}
+
+// Succeeded reports whether the run finished and synced its rows, possibly
+// with warnings.
+func (r SyncRun) Succeeded() bool {
+	return r.Status == "success" || r.Status == "warning"
+}
+
+// Failed reports whether the run finished without syncing its rows: it
+// failed, or was cancelled, interrupted or abandoned.
+func (r SyncRun) Failed() bool {
+	return r.Finished() && !r.Succeeded()
+}
+
+// TriggerSync starts a run of a sync and returns the ID of the run. A full
+// resync syncs every row of the model again instead of only the changes.
+func (c *Client) TriggerSync(
+	ctx context.Context,
+	syncID int,
+	fullResync bool,
+) (int, error) {
+	requestBody := struct {
+		FullResync bool `json:"fullResync"`
+	}{
+		FullResync: fullResync,
+	}
+
+	respBody, err := c.makeRequest(
+		ctx,
+		"POST",
+		fmt.Sprintf("/syncs/%d/trigger", syncID),
+		requestBody,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal TriggerSync response: %w", err)
+	}
+	runID, err := strconv.Atoi(response.ID)
+	if err != nil {
+		return 0, fmt.Errorf("TriggerSync returned an invalid run ID %q", response.ID)
+	}
+
+	return runID, nil
+}
+
+// ListSyncRunsOptions filters the runs returned by ListSyncRuns.
+type ListSyncRunsOptions struct {
+	// RunID, if set, only matches the run with that ID.
+	RunID int
+	// Statuses, if set, only matches runs with one of these statuses. The API
+	// cannot filter by status, so runs are filtered as the pages are read,
+	// and only the MaxStatusFilteredRuns most recent runs matching the other
+	// filters are searched.
+	Statuses []string
+	// After, if set, only matches runs created at or after this time.
+	After time.Time
+	// Before, if set, only matches runs created before this time.
+	Before time.Time
+	// Limit, if set, returns at most this many runs.
+	Limit int
+}
+
+// MaxStatusFilteredRuns bounds the runs ListSyncRuns reads when filtering by
+// status, so that looking for a rare status does not page through the whole
+// run history of the sync.
+const MaxStatusFilteredRuns = 10 * listPageSize
+
+// ErrStatusFilterLimit is returned by ListSyncRuns, along with the runs found,
+// when it stops filtering by status after MaxStatusFilteredRuns runs although
+// older runs remain.
+var ErrStatusFilterLimit = fmt.Errorf("only the %d most recent runs were searched by status", MaxStatusFilteredRuns)
+
+// ListSyncRuns retrieves the runs of a sync, most recent first. Pages are
+// read until Limit matching runs are found, the runs run out or, when
+// filtering by status, MaxStatusFilteredRuns runs have been read, in which
+// case the runs found are returned with ErrStatusFilterLimit.
+func (c *Client) ListSyncRuns(
+	ctx context.Context,
+	syncID int,
+	opts ListSyncRunsOptions,
+) ([]SyncRun, error) {
+	path := fmt.Sprintf("/syncs/%d/runs", syncID)
+	query := url.Values{}
+	if opts.RunID != 0 {
+		query.Set("runId", strconv.Itoa(opts.RunID))
+	}
+	if !opts.After.IsZero() {
+		query.Set("after", opts.After.UTC().Format(time.RFC3339))
+	}
+	if !opts.Before.IsZero() {
+		query.Set("before", opts.Before.UTC().Format(time.RFC3339))
+	}
+
+	statuses := make(map[string]bool, len(opts.Statuses))
+	for _, status := range opts.Statuses {
+		statuses[status] = true
+	}
+
+	// Without a status filter every run read is returned, so there is no
+	// point in reading more than the limit.
+	pageSize := listPageSize
+	if opts.Limit != 0 && opts.Limit < pageSize && len(statuses) == 0 {
+		pageSize = opts.Limit
+	}
+
+	runs := []SyncRun{}
+	for offset := 0; ; {
+		query.Set("limit", strconv.Itoa(pageSize))
+		query.Set("offset", strconv.Itoa(offset))
+
+		respBody, err := c.makeRequest(ctx, "GET", path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page listPage[SyncRun]
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal ListSyncRuns response: %w", err)
+		}
+
+		for _, run := range page.Data {
+			if len(statuses) > 0 && !statuses[run.Status] {
+				continue
+			}
+			runs = append(runs, run)
+			if opts.Limit != 0 && len(runs) == opts.Limit {
+				return runs, nil
+			}
+		}
+		offset += len(page.Data)
+		if !page.HasMore || len(page.Data) == 0 {
+			return runs, nil
+		}
+		if len(statuses) > 0 && offset >= MaxStatusFilteredRuns {
+			return runs, ErrStatusFilterLimit
+		}
+	}
+}
+
+// GetSyncRun retrieves a single run of a sync. An error satisfying
+// IsNotFound is returned if the sync has no run with that ID.
+func (c *Client) GetSyncRun(
+	ctx context.Context,
+	syncID int,
+	runID int,
+) (*SyncRun, error) {
+	runs, err := c.ListSyncRuns(ctx, syncID, ListSyncRunsOptions{RunID: runID, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if run.ID == runID {
+			return &run, nil
+		}
+	}
+	return nil, APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("sync %d has no run %d", syncID, runID),
+	}
+}
+
+// WaitForSyncRun polls a run of a sync every interval until it finishes or
+// ctx is done. It returns the last state of the run it read, which is nil if
+// no read succeeded.
+//
+// Each poll is bounded by the client's request timeout, even though ctx
+// usually has a deadline of its own, and a poll that times out is retried at
+// the next interval.
+func (c *Client) WaitForSyncRun(
+	ctx context.Context,
+	syncID int,
+	runID int,
+	interval time.Duration,
+) (*SyncRun, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *SyncRun
+	for {
+		run, err := c.pollSyncRun(ctx, syncID, runID)
+		if err != nil && ctx.Err() == nil && !errors.Is(err, context.DeadlineExceeded) {
+			return last, err
+		}
+		if err == nil {
+			if run.Finished() {
+				return run, nil
+			}
+			last = run
+		}
+
+		select {
+		case <-ctx.Done():
+			status := "unknown"
+			if last != nil {
+				status = last.Status
+			}
+			return last, fmt.Errorf("sync run %d did not finish in time, last status %q: %w", runID, status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollSyncRun reads a run of a sync for WaitForSyncRun. The request timeout
+// is applied here because send only applies it to contexts without a
+// deadline.
+func (c *Client) pollSyncRun(ctx context.Context, syncID int, runID int) (*SyncRun, error) {
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+	return c.GetSyncRun(ctx, syncID, runID)
+}
@@ -0,0 +1,172 @@
+package hightouch
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Schedule types understood by the Hightouch API.
+const (
+	SyncScheduleInterval = "interval"
+	SyncScheduleCron     = "cron"
+	SyncScheduleVisual   = "visual"
+	SyncScheduleDBTCloud = "dbt-cloud"
+	SyncScheduleManual   = "manual"
+)
+
+// SyncInterval runs a sync every Quantity units (minute, hour, day or week).
+type SyncInterval struct {
+	Quantity int    `json:"quantity"`
+	Unit     string `json:"unit"`
+}
+
+// SyncVisualSchedule runs a sync on the given days of the week, at each of the
+// given times of day (HH:MM, in UTC).
+type SyncVisualSchedule struct {
+	Days  []string
+	Times []string
+}
+
+// SyncSchedule is the typed form of a sync's schedule object. At most one
+// field is set; a zero SyncSchedule means the sync has no schedule.
+type SyncSchedule struct {
+	Interval *SyncInterval
+	Cron     string
+	Visual   *SyncVisualSchedule
+	// DBTCloudJobID runs the sync after the dbt Cloud job with this ID.
+	DBTCloudJobID string
+	// Manual means the sync only runs when it is triggered.
+	Manual bool
+}
+
+// syncScheduleObject is the schedule object of the API.
+type syncScheduleObject struct {
+	Type     string `json:"type"`
+	Schedule struct {
+		Interval    *SyncInterval          `json:"interval,omitempty"`
+		Expression  string                 `json:"expression,omitempty"`
+		Expressions []syncVisualExpression `json:"expressions,omitempty"`
+		Job         *syncDBTCloudJob       `json:"job,omitempty"`
+	} `json:"schedule"`
+}
+
+type syncVisualExpression struct {
+	Days map[string]bool `json:"days"`
+	Time string          `json:"time"`
+}
+
+type syncDBTCloudJob struct {
+	ID string `json:"id"`
+}
+
+// ParseSyncSchedule converts a schedule object returned by the API. An empty
+// object gives a zero SyncSchedule.
+func ParseSyncSchedule(schedule map[string]interface{}) (SyncSchedule, error) {
+	if len(schedule) == 0 {
+		return SyncSchedule{}, nil
+	}
+
+	var object syncScheduleObject
+	if err := convertJSON(schedule, &object); err != nil {
+		return SyncSchedule{}, fmt.Errorf("invalid sync schedule: %w", err)
+	}
+
+	switch object.Type {
+	case SyncScheduleInterval:
+		if object.Schedule.Interval == nil {
+			return SyncSchedule{}, fmt.Errorf("interval schedule has no interval")
+		}
+		return SyncSchedule{Interval: object.Schedule.Interval}, nil
+	case SyncScheduleCron:
+		return SyncSchedule{Cron: object.Schedule.Expression}, nil
+	case SyncScheduleVisual:
+		visual := &SyncVisualSchedule{}
+		for i, expression := range object.Schedule.Expressions {
+			days := make([]string, 0, len(expression.Days))
+			for day, enabled := range expression.Days {
+				if enabled {
+					days = append(days, day)
+				}
+			}
+			sort.Slice(days, func(a, b int) bool { return weekdayIndex(days[a]) < weekdayIndex(days[b]) })
+			if i == 0 {
+				visual.Days = days
+			} else if fmt.Sprint(days) != fmt.Sprint(visual.Days) {
+				return SyncSchedule{}, fmt.Errorf("visual schedule runs on different days at different times, which is not supported")
+			}
+			visual.Times = append(visual.Times, expression.Time)
+		}
+		return SyncSchedule{Visual: visual}, nil
+	case SyncScheduleDBTCloud:
+		if object.Schedule.Job == nil {
+			return SyncSchedule{}, fmt.Errorf("dbt Cloud schedule has no job")
+		}
+		return SyncSchedule{DBTCloudJobID: object.Schedule.Job.ID}, nil
+	case SyncScheduleManual:
+		return SyncSchedule{Manual: true}, nil
+	default:
+		return SyncSchedule{}, fmt.Errorf("unsupported sync schedule type %q", object.Type)
+	}
+}
+
+// Map returns the schedule object sent to the API. A zero SyncSchedule gives
+// an empty object.
+func (s SyncSchedule) Map() (map[string]interface{}, error) {
+	var object syncScheduleObject
+	set := 0
+	if s.Interval != nil {
+		object.Type = SyncScheduleInterval
+		object.Schedule.Interval = s.Interval
+		set++
+	}
+	if s.Cron != "" {
+		object.Type = SyncScheduleCron
+		object.Schedule.Expression = s.Cron
+		set++
+	}
+	if s.Visual != nil {
+		object.Type = SyncScheduleVisual
+		days := make(map[string]bool, len(s.Visual.Days))
+		for _, day := range s.Visual.Days {
+			days[day] = true
+		}
+		for _, time := range s.Visual.Times {
+			object.Schedule.Expressions = append(object.Schedule.Expressions, syncVisualExpression{Days: days, Time: time})
+		}
+		set++
+	}
+	if s.DBTCloudJobID != "" {
+		object.Type = SyncScheduleDBTCloud
+		object.Schedule.Job = &syncDBTCloudJob{ID: s.DBTCloudJobID}
+		set++
+	}
+	if s.Manual {
+		object.Type = SyncScheduleManual
+		set++
+	}
+
+	schedule := map[string]interface{}{}
+	switch set {
+	case 0:
+		return schedule, nil
+	case 1:
+		if err := convertJSON(object, &schedule); err != nil {
+			return nil, fmt.Errorf("invalid sync schedule: %w", err)
+		}
+		return schedule, nil
+	default:
+		return nil, fmt.Errorf("a sync schedule must be exactly one of interval, cron, visual, dbt Cloud or manual")
+	}
+}
+
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// weekdayIndex orders weekday names from Monday; unknown names sort last.
+func weekdayIndex(day string) int {
+	for i, weekday := range weekdays {
+		if day == weekday {
+			return i
+		}
+	}
+	return len(weekdays)
+}
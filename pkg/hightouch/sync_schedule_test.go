@@ -0,0 +1,104 @@
+package hightouch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSyncScheduleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule SyncSchedule
+		want     string
+	}{
+		{
+			name:     "none",
+			schedule: SyncSchedule{},
+			want:     `{}`,
+		},
+		{
+			name:     "interval",
+			schedule: SyncSchedule{Interval: &SyncInterval{Quantity: 6, Unit: "hour"}},
+			want:     `{"type": "interval", "schedule": {"interval": {"quantity": 6, "unit": "hour"}}}`,
+		},
+		{
+			name:     "cron",
+			schedule: SyncSchedule{Cron: "0 6 * * 1-5"},
+			want:     `{"type": "cron", "schedule": {"expression": "0 6 * * 1-5"}}`,
+		},
+		{
+			name: "visual",
+			schedule: SyncSchedule{Visual: &SyncVisualSchedule{
+				Days:  []string{"monday", "friday"},
+				Times: []string{"09:00", "17:30"},
+			}},
+			want: `{"type": "visual", "schedule": {"expressions": [
+				{"days": {"monday": true, "friday": true}, "time": "09:00"},
+				{"days": {"monday": true, "friday": true}, "time": "17:30"}
+			]}}`,
+		},
+		{
+			name:     "dbt cloud",
+			schedule: SyncSchedule{DBTCloudJobID: "4242"},
+			want:     `{"type": "dbt-cloud", "schedule": {"job": {"id": "4242"}}}`,
+		},
+		{
+			name:     "manual",
+			schedule: SyncSchedule{Manual: true},
+			want:     `{"type": "manual", "schedule": {}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.schedule.Map()
+			if err != nil {
+				t.Fatalf("Map() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Map() = %v, want %v", got, want)
+			}
+
+			parsed, err := ParseSyncSchedule(got)
+			if err != nil {
+				t.Fatalf("ParseSyncSchedule() error = %v", err)
+			}
+			if !reflect.DeepEqual(parsed, tt.schedule) {
+				t.Errorf("ParseSyncSchedule() = %+v, want %+v", parsed, tt.schedule)
+			}
+		})
+	}
+}
+
+func TestSyncScheduleMapRejectsSeveralTypes(t *testing.T) {
+	schedule := SyncSchedule{Cron: "0 * * * *", Manual: true}
+	if _, err := schedule.Map(); err == nil {
+		t.Error("Map() error = nil, want an error for a schedule with two types")
+	}
+}
+
+func TestParseSyncScheduleErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown type":        `{"type": "sequence", "schedule": {}}`,
+		"missing interval":    `{"type": "interval", "schedule": {}}`,
+		"different days":      `{"type": "visual", "schedule": {"expressions": [{"days": {"monday": true}, "time": "09:00"}, {"days": {"friday": true}, "time": "17:00"}]}}`,
+		"invalid field types": `{"type": "interval", "schedule": {"interval": {"quantity": "six", "unit": "hour"}}}`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			var schedule map[string]interface{}
+			if err := json.Unmarshal([]byte(input), &schedule); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ParseSyncSchedule(schedule); err == nil {
+				t.Errorf("ParseSyncSchedule(%s) error = nil, want an error", input)
+			}
+		})
+	}
+}
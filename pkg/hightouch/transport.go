@@ -0,0 +1,87 @@
+package hightouch
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportConfig configures the HTTP transport used to reach the API, for
+// networks that route traffic through a proxy or intercept TLS.
+type TransportConfig struct {
+	// ProxyURL, if set, is the proxy every request is sent through. Otherwise
+	// the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables apply.
+	ProxyURL string
+	// CACertPEM holds PEM-encoded CA certificates trusted in addition to the
+	// system pool.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM hold the PEM-encoded certificate and
+	// private key presented for mutual TLS. Both or neither must be set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// NewTransport returns a copy of http.DefaultTransport configured by config.
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL %q: the scheme must be http, https or socks5", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec // explicitly requested by the user
+	}
+	if len(config.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, errors.New("invalid CA bundle: no PEM-encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(config.ClientCertPEM) > 0 || len(config.ClientKeyPEM) > 0 {
+		certificate, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// WithTransport sets the transport used to send requests, such as one
+// returned by NewTransport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithRequestTimeout overrides DefaultRequestTimeout. Zero means requests are
+// only bounded by their context.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
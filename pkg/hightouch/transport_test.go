@@ -0,0 +1,192 @@
+package hightouch
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTLSServer returns a TLS server answering every request with an empty
+// object. If clientCAs is set, the server requires a client certificate
+// signed by one of them.
+func newTLSServer(t *testing.T, clientCAs *x509.CertPool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	if clientCAs != nil {
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// certificatePEM returns the PEM encoding of the certificate of a TLS server.
+func certificatePEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// newClientCertificate returns a self-signed client certificate and its key,
+// PEM-encoded.
+func newClientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() error = %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		certificate
+}
+
+// newTransportClient returns a client that sends requests to baseURL through
+// a transport configured by config, without retries.
+func newTransportClient(t *testing.T, baseURL string, config TransportConfig) *Client {
+	t.Helper()
+
+	transport, err := NewTransport(config)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	return NewClient("key", baseURL, WithRetryPolicy(testRetryPolicy(1)), WithTransport(transport))
+}
+
+func TestTransportCACertificate(t *testing.T) {
+	server := newTLSServer(t, nil)
+
+	client := newTransportClient(t, server.URL, TransportConfig{})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err == nil {
+		t.Error("makeRequest() error = nil, want an error for an untrusted certificate")
+	}
+
+	client = newTransportClient(t, server.URL, TransportConfig{CACertPEM: certificatePEM(server)})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err != nil {
+		t.Errorf("makeRequest() with the CA bundle error = %v", err)
+	}
+
+	client = newTransportClient(t, server.URL, TransportConfig{InsecureSkipVerify: true})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err != nil {
+		t.Errorf("makeRequest() skipping verification error = %v", err)
+	}
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	certPEM, keyPEM, certificate := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(certificate)
+	server := newTLSServer(t, clientCAs)
+
+	client := newTransportClient(t, server.URL, TransportConfig{CACertPEM: certificatePEM(server)})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err == nil {
+		t.Error("makeRequest() error = nil, want an error without a client certificate")
+	}
+
+	client = newTransportClient(t, server.URL, TransportConfig{
+		CACertPEM:     certificatePEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err != nil {
+		t.Errorf("makeRequest() with a client certificate error = %v", err)
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target
+		if r.URL.Host == "api.hightouch.invalid" {
+			proxied.Add(1)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	client := newTransportClient(t, "http://api.hightouch.invalid/api/v1", TransportConfig{ProxyURL: proxy.URL})
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/sources", nil); err != nil {
+		t.Fatalf("makeRequest() error = %v", err)
+	}
+	if proxied.Load() != 1 {
+		t.Errorf("proxy received %d requests, want 1", proxied.Load())
+	}
+}
+
+func TestNewTransportRejectsInvalidConfig(t *testing.T) {
+	certPEM, _, _ := newClientCertificate(t)
+
+	tests := []struct {
+		name    string
+		config  TransportConfig
+		wantErr string
+	}{
+		{name: "proxy scheme", config: TransportConfig{ProxyURL: "ftp://proxy:21"}, wantErr: "scheme"},
+		{name: "CA bundle", config: TransportConfig{CACertPEM: []byte("not a certificate")}, wantErr: "CA bundle"},
+		{name: "client key missing", config: TransportConfig{ClientCertPEM: certPEM}, wantErr: "client certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport(tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewTransport() error = %v, want an error mentioning %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("key", server.URL, WithRetryPolicy(testRetryPolicy(1)), WithRequestTimeout(50*time.Millisecond))
+	if _, err := client.makeRequest(context.Background(), http.MethodGet, "/", nil); err == nil {
+		t.Error("makeRequest() error = nil, want a timeout")
+	}
+}
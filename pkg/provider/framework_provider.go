@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,11 +16,18 @@ import (
 
 	"terraform-provider-hightouch/pkg/hightouch"
 
+	"terraform-provider-hightouch/pkg/framework/objects/destination"
 	"terraform-provider-hightouch/pkg/framework/objects/model"
+	"terraform-provider-hightouch/pkg/framework/objects/source"
 	"terraform-provider-hightouch/pkg/framework/objects/sync"
 
+	bigquerysource "terraform-provider-hightouch/pkg/framework/objects/bigquery_source"
+	databrickssource "terraform-provider-hightouch/pkg/framework/objects/databricks_source"
 	iterabledestination "terraform-provider-hightouch/pkg/framework/objects/iterable_destination"
+	postgressource "terraform-provider-hightouch/pkg/framework/objects/postgres_source"
+	redshiftsource "terraform-provider-hightouch/pkg/framework/objects/redshift_source"
 	snowflakesource "terraform-provider-hightouch/pkg/framework/objects/snowflake_source"
+	syncrun "terraform-provider-hightouch/pkg/framework/objects/sync_run"
 )
 
 type hightouchProvider struct {
@@ -35,8 +46,19 @@ func New(
 }
 
 type hightouchProviderModel struct {
-	APIKey     types.String `tfsdk:"api_key"`
-	APIBaseURL types.String `tfsdk:"api_base_url"`
+	APIKey                types.String  `tfsdk:"api_key"`
+	APIBaseURL            types.String  `tfsdk:"api_base_url"`
+	RetryMaxAttempts      types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryMaxElapsedTime   types.String  `tfsdk:"retry_max_elapsed_time"`
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	ClientCert            types.String  `tfsdk:"client_cert"`
+	ClientKey             types.String  `tfsdk:"client_key"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *hightouchProvider) Metadata(
@@ -66,6 +88,51 @@ func (p *hightouchProvider) Schema(
 				Optional:    true,
 				Sensitive:   false,
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of attempts for a throttled or transiently failing API request, including the first one. Defaults to %d.", hightouch.DefaultRetryMaxAttempts),
+				Optional:    true,
+			},
+			"retry_max_elapsed_time": schema.StringAttribute{
+				Description: fmt.Sprintf("The maximum time to spend retrying a single API request, as a duration string such as \"90s\" or \"5m\". Defaults to %s.", hightouch.DefaultRetryMaxElapsedTime),
+				Optional:    true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "The maximum average number of API requests per second, shared by all resources and data sources, so parallel applies stay below the Hightouch API rate limits. After a quiet period, up to a second's worth of requests, at least one, are sent at once before the rate applies. Fractions such as 0.5 are allowed. Defaults to no limit.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "The maximum number of API requests in flight at once, shared by all resources and data sources. Defaults to no limit.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("The time to wait for a single API request, as a duration string such as \"30s\" or \"2m\". Defaults to %s. Can also be set with the HIGHTOUCH_REQUEST_TIMEOUT environment variable.", hightouch.DefaultRequestTimeout),
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "The URL of the proxy to send API requests through, such as http://proxy.internal:3128. Defaults to the HTTPS_PROXY and NO_PROXY environment variables. Can also be set with the HIGHTOUCH_PROXY_URL environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path to a PEM file of CA certificates to trust in addition to the system ones, for proxies that intercept TLS. Can also be set with the HIGHTOUCH_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to trust in addition to the system ones. Can also be set with the HIGHTOUCH_CA_CERT_PEM environment variable.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "The PEM-encoded client certificate, or the path to a file holding it, presented for mutual TLS. Requires client_key. Can also be set with the HIGHTOUCH_CLIENT_CERT environment variable.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "The PEM-encoded private key of client_cert, or the path to a file holding it. Can also be set with the HIGHTOUCH_CLIENT_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Whether to skip verifying the TLS certificate of the API. Only use this for debugging. Can also be set with the HIGHTOUCH_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -105,8 +172,74 @@ func (p *hightouchProvider) Configure(
 		apiBaseUrl = "https://api.hightouch.com/api/v1"
 	}
 
+	retryPolicy := hightouch.DefaultRetryPolicy()
+	if !config.RetryMaxAttempts.IsNull() {
+		maxAttempts := config.RetryMaxAttempts.ValueInt64()
+		if maxAttempts < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_attempts"),
+				"Invalid Retry Max Attempts",
+				fmt.Sprintf("retry_max_attempts must be at least 1, got %d.", maxAttempts),
+			)
+			return
+		}
+		retryPolicy.MaxAttempts = int(maxAttempts)
+	}
+	if !config.RetryMaxElapsedTime.IsNull() {
+		maxElapsedTime, err := time.ParseDuration(config.RetryMaxElapsedTime.ValueString())
+		if err != nil || maxElapsedTime < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_elapsed_time"),
+				"Invalid Retry Max Elapsed Time",
+				fmt.Sprintf("retry_max_elapsed_time must be a non-negative duration such as \"90s\" or \"5m\", got %q.", config.RetryMaxElapsedTime.ValueString()),
+			)
+			return
+		}
+		retryPolicy.MaxElapsedTime = maxElapsedTime
+	}
+
+	if !config.MaxRequestsPerSecond.IsNull() && config.MaxRequestsPerSecond.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_requests_per_second"),
+			"Invalid Max Requests Per Second",
+			fmt.Sprintf("max_requests_per_second must be greater than 0, got %g.", config.MaxRequestsPerSecond.ValueFloat64()),
+		)
+		return
+	}
+	if !config.MaxConcurrentRequests.IsNull() && config.MaxConcurrentRequests.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			fmt.Sprintf("max_concurrent_requests must be at least 1, got %d.", config.MaxConcurrentRequests.ValueInt64()),
+		)
+		return
+	}
+
+	timeout := requestTimeout(config, &resp.Diagnostics)
+	transport := transportConfig(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := []hightouch.ClientOption{
+		hightouch.WithRetryPolicy(retryPolicy),
+		hightouch.WithRequestTimeout(timeout),
+		// Allow a second's worth of requests at once after a quiet period
+		hightouch.WithRateLimit(config.MaxRequestsPerSecond.ValueFloat64(), int(math.Ceil(config.MaxRequestsPerSecond.ValueFloat64()))),
+		hightouch.WithMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64())),
+		hightouch.WithSensitiveKeys(sensitiveAttributeNames(ctx, p.Resources(ctx))...),
+	}
+	if transport != nil {
+		httpTransport, err := hightouch.NewTransport(*transport)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid HTTP Transport Configuration", err.Error())
+			return
+		}
+		opts = append(opts, hightouch.WithTransport(httpTransport))
+	}
+
 	// Create a new client and make it available to all resources
-	client := hightouch.NewClient(apiKey, apiBaseUrl)
+	client := hightouch.NewClient(apiKey, apiBaseUrl, opts...)
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
@@ -116,10 +249,17 @@ func (p *hightouchProvider) Resources(
 	_ context.Context,
 ) []func() resource.Resource {
 	return []func() resource.Resource{
+		bigquerysource.NewBigQuerySourceResource,
+		databrickssource.NewDatabricksSourceResource,
+		destination.NewDestinationResource,
 		iterabledestination.NewIterableDestinationResource,
 		model.NewModelResource,
+		postgressource.NewPostgresSourceResource,
+		redshiftsource.NewRedshiftSourceResource,
 		snowflakesource.NewSnowflakeSourceResource,
+		source.NewSourceResource,
 		sync.NewSyncResource,
+		syncrun.NewSyncRunResource,
 	}
 }
 
@@ -127,9 +267,18 @@ func (p *hightouchProvider) DataSources(
 	_ context.Context,
 ) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		bigquerysource.NewBigQuerySourceDataSource,
+		databrickssource.NewDatabricksSourceDataSource,
 		iterabledestination.NewIterableDestinationDataSource,
 		model.NewModelDataSource,
+		postgressource.NewPostgresSourceDataSource,
+		redshiftsource.NewRedshiftSourceDataSource,
 		snowflakesource.NewSnowflakeSourceDataSource,
 		sync.NewSyncDataSource,
+		destination.NewDestinationsDataSource,
+		model.NewModelsDataSource,
+		source.NewSourcesDataSource,
+		sync.NewSyncsDataSource,
+		syncrun.NewSyncRunsDataSource,
 	}
 }
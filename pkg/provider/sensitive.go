@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// sensitiveAttributeNames returns the names of all attributes, at any nesting
+// depth, that are marked Sensitive in the schemas of the given resources. The
+// client redacts payload fields with these names from its logs.
+func sensitiveAttributeNames(
+	ctx context.Context,
+	resources []func() resource.Resource,
+) []string {
+	seen := make(map[string]struct{})
+	for _, newResource := range resources {
+		var resp resource.SchemaResponse
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+		collectSensitiveAttributeNames(resp.Schema.Attributes, seen)
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	return names
+}
+
+func collectSensitiveAttributeNames(
+	attributes map[string]schema.Attribute,
+	seen map[string]struct{},
+) {
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			seen[name] = struct{}{}
+		}
+
+		nested, ok := attribute.(schema.NestedAttribute)
+		if !ok {
+			continue
+		}
+		children := make(map[string]schema.Attribute)
+		for childName, child := range nested.GetNestedObject().GetAttributes() {
+			children[childName] = child
+		}
+		collectSensitiveAttributeNames(children, seen)
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-hightouch/pkg/hightouch"
+)
+
+// settingValue returns the configured value of a provider setting, falling
+// back to the environment variable env when it is not configured.
+func settingValue(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// requestTimeout returns the request_timeout setting, or
+// hightouch.DefaultRequestTimeout if it is not set.
+func requestTimeout(config hightouchProviderModel, diags *diag.Diagnostics) time.Duration {
+	value := settingValue(config.RequestTimeout, "HIGHTOUCH_REQUEST_TIMEOUT")
+	if value == "" {
+		return hightouch.DefaultRequestTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid Request Timeout",
+			fmt.Sprintf("request_timeout must be a positive duration such as \"30s\" or \"2m\", got %q.", value),
+		)
+	}
+	return timeout
+}
+
+// transportConfig builds the HTTP transport settings from the provider
+// configuration and the HIGHTOUCH_* environment variables. It returns nil if
+// none is set, so the client keeps the default transport.
+func transportConfig(config hightouchProviderModel, diags *diag.Diagnostics) *hightouch.TransportConfig {
+	var transport hightouch.TransportConfig
+	set := false
+
+	if proxyURL := settingValue(config.ProxyURL, "HIGHTOUCH_PROXY_URL"); proxyURL != "" {
+		transport.ProxyURL = proxyURL
+		set = true
+	}
+
+	if file := settingValue(config.CACertFile, "HIGHTOUCH_CA_CERT_FILE"); file != "" {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", "Could not read the CA bundle: "+err.Error())
+		}
+		transport.CACertPEM = append(transport.CACertPEM, pem...)
+		set = true
+	}
+	if pem := settingValue(config.CACertPEM, "HIGHTOUCH_CA_CERT_PEM"); pem != "" {
+		transport.CACertPEM = append(transport.CACertPEM, '\n')
+		transport.CACertPEM = append(transport.CACertPEM, pem...)
+		set = true
+	}
+
+	clientCert := settingValue(config.ClientCert, "HIGHTOUCH_CLIENT_CERT")
+	clientKey := settingValue(config.ClientKey, "HIGHTOUCH_CLIENT_KEY")
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			diags.AddError(
+				"Incomplete Client Certificate",
+				"client_cert and client_key must be set together to authenticate with mutual TLS.",
+			)
+			return nil
+		}
+		transport.ClientCertPEM = readPEM(clientCert, path.Root("client_cert"), diags)
+		transport.ClientKeyPEM = readPEM(clientKey, path.Root("client_key"), diags)
+		set = true
+	}
+
+	value := boolString(config.InsecureSkipVerify)
+	if value == "" {
+		value = os.Getenv("HIGHTOUCH_INSECURE_SKIP_VERIFY")
+	}
+	if value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid Insecure Skip Verify",
+				fmt.Sprintf("HIGHTOUCH_INSECURE_SKIP_VERIFY must be true or false, got %q.", value),
+			)
+		}
+		transport.InsecureSkipVerify = insecure
+		set = true
+	}
+
+	if !set || diags.HasError() {
+		return nil
+	}
+	return &transport
+}
+
+// readPEM returns value if it holds PEM-encoded data, and otherwise reads the
+// file it names.
+func readPEM(value string, attribute path.Path, diags *diag.Diagnostics) []byte {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value)
+	}
+	pem, err := os.ReadFile(value)
+	if err != nil {
+		diags.AddAttributeError(attribute, "Invalid PEM Value", "The value is neither PEM-encoded nor a readable file: "+err.Error())
+	}
+	return pem
+}
+
+// boolString returns a configured boolean as a string, or "" if it is null.
+func boolString(value types.Bool) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return strconv.FormatBool(value.ValueBool())
+}